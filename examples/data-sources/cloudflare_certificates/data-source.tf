@@ -0,0 +1,17 @@
+# Certificates across every zone in the account expiring in the next 30 days.
+data "cloudflare_certificates" "expiring" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+
+  filter {
+    expires_within_days = 30
+  }
+}
+
+# Certificate packs and custom SSL certificates of a single zone.
+data "cloudflare_certificates" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+
+  filter {
+    types = ["certificate_pack", "custom_ssl"]
+  }
+}
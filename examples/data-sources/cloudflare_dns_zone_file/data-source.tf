@@ -0,0 +1,8 @@
+data "cloudflare_dns_zone_file" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+}
+
+resource "local_file" "backup" {
+  filename = "${path.module}/example.com.zone"
+  content  = data.cloudflare_dns_zone_file.example.zone_file
+}
@@ -0,0 +1,9 @@
+data "cloudflare_records" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+
+  filter {
+    type      = "CNAME"
+    tags      = ["owner:team-a", "production"]
+    tag_match = "all"
+  }
+}
@@ -0,0 +1,6 @@
+data "cloudflare_worker_scripts" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  filter {
+    name = "^api-"
+  }
+}
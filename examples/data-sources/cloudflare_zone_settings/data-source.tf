@@ -0,0 +1,24 @@
+data "cloudflare_zone_settings" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+}
+
+# Assert every active zone enforces TLS 1.2 or later.
+data "cloudflare_zones" "all" {
+  filter {
+    status = "active"
+  }
+}
+
+data "cloudflare_zone_settings" "all" {
+  for_each = { for zone in data.cloudflare_zones.all.zones : zone.name => zone.id }
+  zone_id  = each.value
+}
+
+check "min_tls_version" {
+  assert {
+    condition = alltrue([
+      for settings in data.cloudflare_zone_settings.all : contains(["1.2", "1.3"], settings.settings[0].min_tls_version)
+    ])
+    error_message = "All zones must enforce TLS 1.2 or later."
+  }
+}
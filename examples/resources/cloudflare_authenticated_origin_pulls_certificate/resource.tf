@@ -13,3 +13,17 @@ resource "cloudflare_authenticated_origin_pulls_certificate" "my_per_hostname_ao
   private_key = "-----INSERT PRIVATE KEY-----"
   type        = "per-hostname"
 }
+
+# Per-Hostname Authenticated Origin Pulls certificate which is rotated by
+# moving the hostnames onto the new certificate before deleting the old one.
+resource "cloudflare_authenticated_origin_pulls_certificate" "my_rotated_aop_cert" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  certificate = "-----INSERT CERTIFICATE-----"
+  private_key = "-----INSERT PRIVATE KEY-----"
+  type        = "per-hostname"
+  hostnames   = ["aop.example.com", "origin.example.com"]
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
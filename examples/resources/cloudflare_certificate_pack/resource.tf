@@ -20,3 +20,18 @@ resource "cloudflare_certificate_pack" "example" {
   cloudflare_branding    = false
   wait_for_active_status = true
 }
+
+# Restart the validation of a pack which timed out by changing any value in
+# `restart_validation`.
+resource "cloudflare_certificate_pack" "example" {
+  zone_id               = "0da42c8d2132a9ddaf714f9e7c920711"
+  type                  = "advanced"
+  hosts                 = ["example.com", "*.example.com"]
+  validation_method     = "txt"
+  validity_days         = 90
+  certificate_authority = "google"
+
+  restart_validation = {
+    attempt = "2"
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_client_certificate.example <zone_id>/<client_certificate_id>
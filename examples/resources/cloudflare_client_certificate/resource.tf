@@ -0,0 +1,22 @@
+resource "tls_private_key" "example" {
+  algorithm   = "ECDSA"
+  ecdsa_curve = "P256"
+}
+
+resource "tls_cert_request" "example" {
+  private_key_pem = tls_private_key.example.private_key_pem
+
+  subject {
+    common_name  = "device-1234"
+    organization = "Example Inc"
+  }
+}
+
+resource "cloudflare_client_certificate" "example" {
+  zone_id       = "0da42c8d2132a9ddaf714f9e7c920711"
+  csr           = tls_cert_request.example.cert_request_pem
+  validity_days = 365
+
+  # Set to true to revoke the certificate, and back to false to reactivate it.
+  revoked = false
+}
@@ -0,0 +1,5 @@
+# Hostnames of the Cloudflare managed certificate authority.
+$ terraform import cloudflare_client_certificate_hostnames.example <zone_id>
+
+# Hostnames of an uploaded certificate authority.
+$ terraform import cloudflare_client_certificate_hostnames.example <zone_id>/<mtls_certificate_id>
@@ -0,0 +1,12 @@
+# Hostnames validating certificates issued by `cloudflare_client_certificate`.
+resource "cloudflare_client_certificate_hostnames" "example" {
+  zone_id   = "0da42c8d2132a9ddaf714f9e7c920711"
+  hostnames = ["api.example.com", "mtls.example.com"]
+}
+
+# Hostnames validating certificates issued by an uploaded certificate authority.
+resource "cloudflare_client_certificate_hostnames" "uploaded" {
+  zone_id             = "0da42c8d2132a9ddaf714f9e7c920711"
+  mtls_certificate_id = cloudflare_mtls_certificate.example.id
+  hostnames           = ["partners.example.com"]
+}
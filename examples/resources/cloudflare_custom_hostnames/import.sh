@@ -0,0 +1,2 @@
+# Importing adopts every custom hostname of the zone.
+$ terraform import cloudflare_custom_hostnames.example <zone_id>
@@ -0,0 +1,29 @@
+resource "cloudflare_custom_hostnames" "example" {
+  zone_id         = "0da42c8d2132a9ddaf714f9e7c920711"
+  batch_size      = 20
+  wait_for_active = true
+  timeout         = "1h"
+
+  hostnames = {
+    "app.customer-a.com" = {
+      ssl = {
+        method = "txt"
+        type   = "dv"
+        settings = {
+          min_tls_version = "1.2"
+          tls13           = "on"
+        }
+      }
+    }
+
+    "app.customer-b.com" = {
+      custom_origin_server = "origin-b.example.com"
+      custom_metadata = {
+        customer = "b"
+      }
+      ssl = {
+        method = "http"
+      }
+    }
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_custom_nameserver.example <account_id>/<ns_name>
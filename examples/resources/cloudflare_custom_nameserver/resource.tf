@@ -0,0 +1,11 @@
+resource "cloudflare_custom_nameserver" "ns1" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  ns_name    = "ns1.example.com"
+  ns_set     = 1
+}
+
+resource "cloudflare_custom_nameserver" "ns2" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  ns_name    = "ns2.example.com"
+  ns_set     = 1
+}
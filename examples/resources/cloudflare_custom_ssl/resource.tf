@@ -1,5 +1,6 @@
 resource "cloudflare_custom_ssl" "example" {
-  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  zone_id              = "0da42c8d2132a9ddaf714f9e7c920711"
+  min_days_for_renewal = 30
   custom_ssl_options {
     certificate      = "-----INSERT CERTIFICATE-----"
     private_key      = "-----INSERT PRIVATE KEY-----"
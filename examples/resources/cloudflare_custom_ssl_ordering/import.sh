@@ -0,0 +1 @@
+$ terraform import cloudflare_custom_ssl_ordering.example <zone_id>
@@ -0,0 +1,7 @@
+resource "cloudflare_custom_ssl_ordering" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  certificate_ids = [
+    cloudflare_custom_ssl.ecdsa.id,
+    cloudflare_custom_ssl.rsa.id,
+  ]
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_d1_migrations.example <account_id>/<database_id>
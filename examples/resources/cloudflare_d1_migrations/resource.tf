@@ -0,0 +1,17 @@
+resource "cloudflare_d1_database" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "terraform-database"
+}
+
+resource "cloudflare_d1_migrations" "example" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  database_id = cloudflare_d1_database.example.id
+
+  dynamic "migration" {
+    for_each = sort(fileset("${path.module}/migrations", "*.sql"))
+    content {
+      name = migration.value
+      sql  = file("${path.module}/migrations/${migration.value}")
+    }
+  }
+}
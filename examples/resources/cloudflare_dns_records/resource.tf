@@ -0,0 +1,52 @@
+# Manage every record in the zone.
+resource "cloudflare_dns_records" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+
+  records = [
+    {
+      name    = "@"
+      type    = "A"
+      content = "192.0.2.1"
+      proxied = true
+    },
+    {
+      name    = "www"
+      type    = "CNAME"
+      content = "example.com"
+      proxied = true
+    },
+    {
+      name     = "@"
+      type     = "MX"
+      content  = "mx.example.net"
+      priority = 10
+    },
+    {
+      name = "@"
+      type = "CAA"
+      data = {
+        flags = "0"
+        tag   = "issue"
+        value = "letsencrypt.org"
+      }
+    },
+  ]
+}
+
+# Only manage TXT records, taking over the existing ones.
+resource "cloudflare_dns_records" "txt" {
+  zone_id         = "0da42c8d2132a9ddaf714f9e7c920711"
+  allow_overwrite = true
+
+  filter {
+    types = ["TXT"]
+  }
+
+  records = [
+    {
+      name    = "@"
+      type    = "TXT"
+      content = "v=spf1 include:_spf.example.net -all"
+    },
+  ]
+}
@@ -0,0 +1,5 @@
+resource "cloudflare_dns_zone_file" "example" {
+  zone_id       = "0da42c8d2132a9ddaf714f9e7c920711"
+  zone_file     = file("${path.module}/example.com.zone")
+  skip_existing = true
+}
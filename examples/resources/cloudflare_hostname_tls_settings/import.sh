@@ -0,0 +1,2 @@
+# Importing adopts every hostname of the zone with a TLS setting.
+$ terraform import cloudflare_hostname_tls_settings.example <zone_id>
@@ -0,0 +1,15 @@
+resource "cloudflare_hostname_tls_settings" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+
+  hostnames = {
+    "customer-a.example.com" = {
+      min_tls_version = "1.2"
+      http2           = "on"
+      tls_1_3         = "on"
+    }
+    "customer-b.example.com" = {
+      min_tls_version = "1.3"
+      ciphers         = ["ECDHE-ECDSA-AES128-GCM-SHA256", "ECDHE-RSA-AES128-GCM-SHA256"]
+    }
+  }
+}
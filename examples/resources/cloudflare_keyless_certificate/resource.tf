@@ -6,4 +6,23 @@ resource "cloudflare_keyless_certificate" "example" {
   port          = 24008
   enabled       = true
   certificate   = "-----INSERT CERTIFICATE-----"
-}
\ No newline at end of file
+}
+# Key server reachable through a Cloudflare Tunnel.
+resource "cloudflare_tunnel_virtual_network" "keyless" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "keyless"
+}
+
+resource "cloudflare_keyless_certificate" "tunnel" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  name        = "example.com Keyless SSL via Tunnel"
+  host        = "keyless.example.com"
+  port        = 24008
+  enabled     = true
+  certificate = "-----INSERT CERTIFICATE-----"
+
+  tunnel {
+    vnet_id    = cloudflare_tunnel_virtual_network.keyless.id
+    private_ip = "10.0.0.10"
+  }
+}
@@ -16,4 +16,32 @@ resource "cloudflare_origin_ca_certificate" "example" {
   hostnames          = ["example.com"]
   request_type       = "origin-rsa"
   requested_validity = 7
+
+  # Keep the certificate valid when it is removed from Terraform.
+  revoke_on_destroy = false
+}
+
+# Generate the private key and CSR locally, rotating the key yearly and renewing
+# the certificate on the first plan made within 30 days of its expiry.
+resource "time_rotating" "example" {
+  rotation_years = 1
+}
+
+# Changing the hostnames reissues the certificate in place, the previous
+# certificate is only revoked once the new one has been issued.
+resource "cloudflare_origin_ca_certificate" "generated" {
+  hostnames            = ["example.com", "*.example.com"]
+  request_type         = "origin-ecc"
+  requested_validity   = 365
+  min_days_for_renewal = 30
+
+  key_generation {
+    algorithm    = "ECDSA"
+    ecdsa_curve  = "P384"
+    organization = "Example"
+
+    rotation_triggers = {
+      rotation = time_rotating.example.id
+    }
+  }
 }
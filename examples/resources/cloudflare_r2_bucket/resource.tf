@@ -3,3 +3,30 @@ resource "cloudflare_r2_bucket" "example" {
   name       = "terraform-bucket"
   location   = "enam"
 }
+
+resource "cloudflare_r2_bucket" "assets" {
+  account_id     = "f037e56e89293a057740de681ac9abbe"
+  name           = "terraform-assets"
+  r2_dev_enabled = false
+
+  lifecycle_rule {
+    id                                   = "expire-logs"
+    prefix                               = "logs/"
+    expiration_days                      = 30
+    abort_multipart_uploads_days         = 1
+    transition_to_infrequent_access_days = 7
+  }
+
+  cors_rule {
+    allowed_methods = ["GET", "HEAD"]
+    allowed_origins = ["https://example.com"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = 3600
+  }
+
+  custom_domain {
+    domain  = "assets.example.com"
+    zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+    min_tls = "1.2"
+  }
+}
@@ -0,0 +1,6 @@
+resource "cloudflare_secondary_dns_incoming" "example" {
+  zone_id              = "0da42c8d2132a9ddaf714f9e7c920711"
+  name                 = "example.com"
+  auto_refresh_seconds = 86400
+  peers                = [cloudflare_secondary_dns_peer.example.id]
+}
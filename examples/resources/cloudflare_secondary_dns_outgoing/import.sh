@@ -0,0 +1 @@
+$ terraform import cloudflare_secondary_dns_outgoing.example <zone_id>
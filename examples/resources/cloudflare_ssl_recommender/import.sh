@@ -0,0 +1 @@
+$ terraform import cloudflare_ssl_recommender.example <zone_id>
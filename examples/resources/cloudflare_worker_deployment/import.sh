@@ -0,0 +1 @@
+$ terraform import cloudflare_worker_deployment.example <account_id>/<script_name>
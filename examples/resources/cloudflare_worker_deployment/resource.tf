@@ -0,0 +1,16 @@
+# Gradually roll out a new version by sending 10% of traffic to it.
+resource "cloudflare_worker_deployment" "example" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  script_name = "my-worker"
+  message     = "Canary release"
+
+  version {
+    version_id = cloudflare_worker_version.stable.id
+    percentage = 90
+  }
+
+  version {
+    version_id = cloudflare_worker_version.canary.id
+    percentage = 10
+  }
+}
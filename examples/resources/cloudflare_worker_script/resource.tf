@@ -44,4 +44,8 @@ resource "cloudflare_worker_script" "my_script" {
     name    = "MY_DATASET"
     dataset = "dataset1"
   }
+
+  tail_consumers {
+    service = "observability"
+  }
 }
@@ -0,0 +1,17 @@
+resource "cloudflare_worker_version" "example" {
+  account_id         = "f037e56e89293a057740de681ac9abbe"
+  script_name        = "my-worker"
+  content            = file("worker.mjs")
+  compatibility_date = "2024-01-01"
+  message            = "Add greeting binding"
+
+  plain_text_binding {
+    name = "GREETING"
+    text = "hello"
+  }
+
+  kv_namespace_binding {
+    name         = "MY_NAMESPACE"
+    namespace_id = "5f7c4c32ec8f4a3f8a4d6b8b1d4a5c21"
+  }
+}
@@ -0,0 +1,14 @@
+resource "cloudflare_zone" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  zone       = "example.com"
+}
+
+# Delegate `cloudflare_zone.example.name_servers` at the registrar, then:
+resource "cloudflare_zone_activation" "example" {
+  zone_id = cloudflare_zone.example.id
+  timeout = "2h"
+
+  triggers = {
+    name_servers = join(",", cloudflare_zone.example.name_servers)
+  }
+}
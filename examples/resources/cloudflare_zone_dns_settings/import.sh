@@ -0,0 +1 @@
+$ terraform import cloudflare_zone_dns_settings.example <zone_id>
@@ -0,0 +1,15 @@
+resource "cloudflare_zone_dns_settings" "example" {
+  zone_id        = "0da42c8d2132a9ddaf714f9e7c920711"
+  multi_provider = true
+  ns_ttl         = 86400
+
+  nameservers {
+    type   = "custom.account"
+    ns_set = 1
+  }
+
+  soa {
+    min_ttl = 1800
+    rname   = "hostmaster.example.com"
+  }
+}
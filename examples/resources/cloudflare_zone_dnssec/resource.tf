@@ -5,3 +5,14 @@ resource "cloudflare_zone" "example" {
 resource "cloudflare_zone_dnssec" "example" {
   zone_id = cloudflare_zone.example.id
 }
+
+# Multi-signer DNSSEC, the DS record to publish at the registrar is
+# available as `ds_record`.
+resource "cloudflare_zone" "multi_signer" {
+  zone = "example.net"
+}
+
+resource "cloudflare_zone_dnssec" "multi_signer" {
+  zone_id             = cloudflare_zone.multi_signer.id
+  dnssec_multi_signer = true
+}
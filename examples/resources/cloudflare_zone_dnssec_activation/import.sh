@@ -0,0 +1 @@
+$ terraform import cloudflare_zone_dnssec_activation.example <zone_id>
@@ -0,0 +1,13 @@
+resource "cloudflare_zone_dnssec" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+}
+
+# Publish `cloudflare_zone_dnssec.example.ds_record` at the registrar, then:
+resource "cloudflare_zone_dnssec_activation" "example" {
+  zone_id = cloudflare_zone_dnssec.example.zone_id
+  timeout = "2h"
+
+  triggers = {
+    ds_record = cloudflare_zone_dnssec.example.ds_record
+  }
+}
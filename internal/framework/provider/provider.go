@@ -12,15 +12,37 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/api_token_permissions_groups"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/certificates"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/client_certificate"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/custom_hostnames"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/custom_nameserver"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/custom_ssl_ordering"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/d1"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/dns_records"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/dns_zone_file"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/email_routing_address"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/email_routing_rule"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/hostname_tls_settings"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/list_item"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/origin_ca_certificate"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/queue"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/r2_bucket"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/record"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/rulesets"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/secondary_dns_incoming"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/secondary_dns_outgoing"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/secondary_dns_peer"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/secondary_dns_tsig"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/ssl_recommender"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/turnstile"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/user"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/worker_deployment"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/worker_script"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/worker_version"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/workers_kv_namespace"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/zone_activation"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/zone_dns_settings"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/zone_dnssec_activation"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/sdkv2provider"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -330,21 +352,49 @@ func (p *CloudflareProvider) Configure(ctx context.Context, req provider.Configu
 
 func (p *CloudflareProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		client_certificate.NewResource,
+		client_certificate.NewHostnamesResource,
+		custom_hostnames.NewResource,
+		custom_nameserver.NewResource,
+		custom_ssl_ordering.NewResource,
 		d1.NewResource,
+		d1.NewMigrationsResource,
+		dns_records.NewResource,
+		dns_zone_file.NewResource,
 		email_routing_address.NewResource,
 		email_routing_rule.NewResource,
+		hostname_tls_settings.NewResource,
 		list_item.NewResource,
+		origin_ca_certificate.NewResource,
 		r2_bucket.NewResource,
 		rulesets.NewResource,
+		secondary_dns_incoming.NewResource,
+		secondary_dns_outgoing.NewResource,
+		secondary_dns_peer.NewResource,
+		secondary_dns_tsig.NewResource,
+		ssl_recommender.NewResource,
 		turnstile.NewResource,
+		worker_deployment.NewResource,
+		worker_version.NewResource,
+		zone_activation.NewResource,
+		zone_dns_settings.NewResource,
+		zone_dnssec_activation.NewResource,
 	}
 }
 
 func (p *CloudflareProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		api_token_permissions_groups.NewDataSource,
+		certificates.NewDataSource,
+		d1.NewDataSource,
+		dns_zone_file.NewDataSource,
 		origin_ca_certificate.NewDataSource,
+		queue.NewDataSource,
+		r2_bucket.NewDataSource,
+		record.NewDataSource,
 		user.NewDataSource,
+		worker_script.NewDataSource,
+		workers_kv_namespace.NewDataSource,
 	}
 }
 
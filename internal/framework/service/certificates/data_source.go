@@ -0,0 +1,121 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CertificatesDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &CertificatesDataSource{}
+}
+
+// CertificatesDataSource defines the data source implementation.
+type CertificatesDataSource struct {
+	client *cloudflare.API
+}
+
+func (r *CertificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+}
+
+func (r *CertificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CertificatesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kinds := make(map[string]bool, len(certificateTypes))
+	var cutoff time.Time
+	if data.Filter != nil && len(data.Filter.Types) > 0 {
+		for _, kind := range data.Filter.Types {
+			kinds[kind.ValueString()] = true
+		}
+	} else {
+		for _, kind := range certificateTypes {
+			kinds[kind] = true
+		}
+	}
+	if data.Filter != nil && !data.Filter.ExpiresWithinDays.IsNull() {
+		cutoff = time.Now().AddDate(0, 0, int(data.Filter.ExpiresWithinDays.ValueInt64()))
+	}
+
+	zoneIDs := []string{data.ZoneID.ValueString()}
+	data.ID = data.ZoneID
+	if !data.AccountID.IsNull() {
+		zones, err := r.client.ListZonesContext(ctx, cloudflare.WithZoneFilters("", data.AccountID.ValueString(), ""))
+		if err != nil {
+			resp.Diagnostics.AddError("failed to list zones", err.Error())
+			return
+		}
+
+		zoneIDs = make([]string, 0, len(zones.Result))
+		for _, zone := range zones.Result {
+			zoneIDs = append(zoneIDs, zone.ID)
+		}
+		data.ID = data.AccountID
+	}
+
+	var certificates []certificate
+	for _, zoneID := range zoneIDs {
+		zoneCertificates, err := listZoneCertificates(ctx, r.client, zoneID, kinds)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to list certificates", err.Error())
+			return
+		}
+		certificates = append(certificates, zoneCertificates...)
+	}
+
+	certificates = filterCertificates(certificates, cutoff)
+
+	data.Certificates = make([]*CertificateModel, 0, len(certificates))
+	for _, c := range certificates {
+		model := &CertificateModel{
+			ID:        types.StringValue(c.ID),
+			ZoneID:    types.StringValue(c.ZoneID),
+			Type:      types.StringValue(c.Type),
+			Hosts:     make([]types.String, 0, len(c.Hosts)),
+			Issuer:    types.StringValue(c.Issuer),
+			Authority: types.StringValue(c.Authority),
+			Status:    types.StringValue(c.Status),
+			ExpiresOn: types.StringNull(),
+		}
+		for _, host := range c.Hosts {
+			model.Hosts = append(model.Hosts, types.StringValue(host))
+		}
+		if !c.ExpiresOn.IsZero() {
+			model.ExpiresOn = types.StringValue(c.ExpiresOn.Format(time.RFC3339))
+		}
+
+		data.Certificates = append(data.Certificates, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
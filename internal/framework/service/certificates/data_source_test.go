@@ -0,0 +1,70 @@
+package certificates_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudflareCertificatesDataSource_CustomSSL(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := "data.cloudflare_certificates." + rnd
+
+	cert, key, err := utils.GenerateEphemeralCertAndKey([]string{rnd + "." + domain}, time.Now().AddDate(0, 0, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareCertificatesDataSourceConfig(rnd, zoneID, cert, key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", zoneID),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "certificates.*", map[string]string{
+						"zone_id": zoneID,
+						"type":    "custom_ssl",
+						"hosts.0": rnd + "." + domain,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareCertificatesDataSourceConfig(rnd, zoneID, cert, key string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_custom_ssl" "%[1]s" {
+  zone_id = "%[2]s"
+
+  custom_ssl_options {
+    certificate = <<EOT
+%[3]sEOT
+    private_key = <<EOT
+%[4]sEOT
+  }
+}
+
+data "cloudflare_certificates" "%[1]s" {
+  zone_id = "%[2]s"
+
+  filter {
+    types               = ["custom_ssl"]
+    expires_within_days = 7
+  }
+
+  depends_on = [cloudflare_custom_ssl.%[1]s]
+}`, rnd, zoneID, cert, key)
+}
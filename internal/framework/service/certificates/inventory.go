@@ -0,0 +1,275 @@
+package certificates
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+)
+
+const (
+	certificateTypeCertificatePack = "certificate_pack"
+	certificateTypeCustomSSL       = "custom_ssl"
+	certificateTypeCustomHostname  = "custom_hostname"
+	certificateTypeOriginCA        = "origin_ca"
+)
+
+var certificateTypes = []string{
+	certificateTypeCertificatePack,
+	certificateTypeCustomSSL,
+	certificateTypeCustomHostname,
+	certificateTypeOriginCA,
+}
+
+// certificate is the shape every kind of certificate is reduced to. A zero
+// ExpiresOn means no certificate has been issued yet.
+type certificate struct {
+	ID        string
+	ZoneID    string
+	Type      string
+	Hosts     []string
+	Issuer    string
+	Authority string
+	Status    string
+	ExpiresOn time.Time
+}
+
+// listZoneCertificates fetches the certificates of the requested kinds for
+// a single zone.
+func listZoneCertificates(ctx context.Context, client *cloudflare.API, zoneID string, kinds map[string]bool) ([]certificate, error) {
+	var certificates []certificate
+
+	if kinds[certificateTypeCertificatePack] {
+		query := url.Values{}
+		query.Set("status", "all")
+		err := listPages(ctx, client, fmt.Sprintf("/zones/%s/ssl/certificate_packs", zoneID), query, func(result json.RawMessage) (int, error) {
+			var packs []cloudflare.CertificatePack
+			if err := json.Unmarshal(result, &packs); err != nil {
+				return 0, err
+			}
+			certificates = append(certificates, certificatePackCertificates(zoneID, packs)...)
+			return len(packs), nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing certificate packs for zone %q: %w", zoneID, err)
+		}
+	}
+
+	if kinds[certificateTypeCustomSSL] {
+		err := listPages(ctx, client, fmt.Sprintf("/zones/%s/custom_certificates", zoneID), url.Values{}, func(result json.RawMessage) (int, error) {
+			var customSSL []cloudflare.ZoneCustomSSL
+			if err := json.Unmarshal(result, &customSSL); err != nil {
+				return 0, err
+			}
+			certificates = append(certificates, customSSLCertificates(zoneID, customSSL)...)
+			return len(customSSL), nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing custom SSL certificates for zone %q: %w", zoneID, err)
+		}
+	}
+
+	if kinds[certificateTypeCustomHostname] {
+		for page := 1; ; page++ {
+			hostnames, resultInfo, err := client.CustomHostnames(ctx, zoneID, page, cloudflare.CustomHostname{})
+			if err != nil {
+				return nil, fmt.Errorf("error listing custom hostnames for zone %q: %w", zoneID, err)
+			}
+			certificates = append(certificates, customHostnameCertificates(zoneID, hostnames)...)
+
+			if len(hostnames) == 0 || page >= resultInfo.TotalPages {
+				break
+			}
+		}
+	}
+
+	if kinds[certificateTypeOriginCA] {
+		query := url.Values{}
+		query.Set("zone_id", zoneID)
+		err := listPages(ctx, client, "/certificates", query, func(result json.RawMessage) (int, error) {
+			var originCA []cloudflare.OriginCACertificate
+			if err := json.Unmarshal(result, &originCA); err != nil {
+				return 0, err
+			}
+			certificates = append(certificates, originCACertificates(zoneID, originCA)...)
+			return len(originCA), nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing Origin CA certificates for zone %q: %w", zoneID, err)
+		}
+	}
+
+	return certificates, nil
+}
+
+// listPages requests every page of a listing endpoint, as the `cloudflare-go`
+// methods for certificate packs, custom SSL and Origin CA certificates only
+// return the first one. `add` handles the results of a page and returns how
+// many there were.
+func listPages(ctx context.Context, client *cloudflare.API, uri string, query url.Values, add func(result json.RawMessage) (int, error)) error {
+	query.Set("per_page", "50")
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s?%s", uri, query.Encode()), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		count, err := add(res.Result)
+		if err != nil {
+			return err
+		}
+
+		if count == 0 || res.ResultInfo == nil || page >= res.ResultInfo.TotalPages {
+			return nil
+		}
+	}
+}
+
+// certificatePackCertificates reports each pack once, using whichever of its
+// certificates expires first.
+func certificatePackCertificates(zoneID string, packs []cloudflare.CertificatePack) []certificate {
+	certificates := make([]certificate, 0, len(packs))
+	for _, pack := range packs {
+		c := certificate{
+			ID:        pack.ID,
+			ZoneID:    zoneID,
+			Type:      certificateTypeCertificatePack,
+			Hosts:     pack.Hosts,
+			Authority: pack.CertificateAuthority,
+			Status:    pack.Status,
+		}
+
+		for _, cert := range pack.Certificates {
+			if cert.ExpiresOn.IsZero() {
+				continue
+			}
+			if c.ExpiresOn.IsZero() || cert.ExpiresOn.Before(c.ExpiresOn) {
+				c.Issuer = cert.Issuer
+				c.ExpiresOn = cert.ExpiresOn
+			}
+		}
+
+		certificates = append(certificates, c)
+	}
+
+	return certificates
+}
+
+func customSSLCertificates(zoneID string, customSSL []cloudflare.ZoneCustomSSL) []certificate {
+	certificates := make([]certificate, 0, len(customSSL))
+	for _, cert := range customSSL {
+		certificates = append(certificates, certificate{
+			ID:        cert.ID,
+			ZoneID:    zoneID,
+			Type:      certificateTypeCustomSSL,
+			Hosts:     cert.Hosts,
+			Issuer:    cert.Issuer,
+			Status:    cert.Status,
+			ExpiresOn: cert.ExpiresOn,
+		})
+	}
+
+	return certificates
+}
+
+// customHostnameCertificates reports the certificate of each custom hostname
+// which has SSL configured.
+func customHostnameCertificates(zoneID string, hostnames []cloudflare.CustomHostname) []certificate {
+	certificates := make([]certificate, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		if hostname.SSL == nil {
+			continue
+		}
+
+		c := certificate{
+			ID:        hostname.SSL.ID,
+			ZoneID:    zoneID,
+			Type:      certificateTypeCustomHostname,
+			Hosts:     []string{hostname.Hostname},
+			Issuer:    hostname.SSL.Issuer,
+			Authority: hostname.SSL.CertificateAuthority,
+			Status:    hostname.SSL.Status,
+		}
+		if c.ID == "" {
+			c.ID = hostname.ID
+		}
+		if hostname.SSL.CustomCertificate != "" {
+			c.Authority = ""
+		}
+
+		for _, cert := range hostname.SSL.Certificates {
+			if cert.ExpiresOn == nil || cert.ExpiresOn.IsZero() {
+				continue
+			}
+			if c.ExpiresOn.IsZero() || cert.ExpiresOn.Before(c.ExpiresOn) {
+				c.Issuer = cert.Issuer
+				c.ExpiresOn = *cert.ExpiresOn
+			}
+		}
+
+		certificates = append(certificates, c)
+	}
+
+	return certificates
+}
+
+func originCACertificates(zoneID string, originCA []cloudflare.OriginCACertificate) []certificate {
+	certificates := make([]certificate, 0, len(originCA))
+	for _, cert := range originCA {
+		c := certificate{
+			ID:        cert.ID,
+			ZoneID:    zoneID,
+			Type:      certificateTypeOriginCA,
+			Hosts:     cert.Hostnames,
+			Authority: "cloudflare",
+			Status:    "active",
+			ExpiresOn: cert.ExpiresOn,
+		}
+		if !cert.RevokedAt.IsZero() {
+			c.Status = "revoked"
+		}
+
+		// The issuer is only available from the certificate itself.
+		if chain, err := utils.ParseCertificateChain(cert.Certificate); err == nil {
+			c.Issuer = chain[0].Issuer.CommonName
+		}
+
+		certificates = append(certificates, c)
+	}
+
+	return certificates
+}
+
+// filterCertificates drops certificates which do not expire before the
+// cutoff, when one is given, and orders the rest by expiry. Certificates
+// which have not been issued yet sort last.
+func filterCertificates(certificates []certificate, cutoff time.Time) []certificate {
+	filtered := make([]certificate, 0, len(certificates))
+	for _, c := range certificates {
+		if !cutoff.IsZero() && (c.ExpiresOn.IsZero() || c.ExpiresOn.After(cutoff)) {
+			continue
+		}
+		filtered = append(filtered, c)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		a, b := filtered[i], filtered[j]
+		if a.ExpiresOn.IsZero() != b.ExpiresOn.IsZero() {
+			return b.ExpiresOn.IsZero()
+		}
+		if !a.ExpiresOn.Equal(b.ExpiresOn) {
+			return a.ExpiresOn.Before(b.ExpiresOn)
+		}
+		return a.ID < b.ID
+	})
+
+	return filtered
+}
@@ -0,0 +1,137 @@
+package certificates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificatePackCertificates(t *testing.T) {
+	soon := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	later := soon.AddDate(0, 1, 0)
+
+	certificates := certificatePackCertificates("zone", []cloudflare.CertificatePack{
+		{
+			ID:                   "pack",
+			Hosts:                []string{"example.com", "*.example.com"},
+			Status:               "active",
+			CertificateAuthority: "lets_encrypt",
+			Certificates: []cloudflare.CertificatePackCertificate{
+				{Issuer: "LetsEncrypt RSA", ExpiresOn: later},
+				{Issuer: "LetsEncrypt ECDSA", ExpiresOn: soon},
+			},
+		},
+		{
+			ID:     "pending",
+			Hosts:  []string{"pending.example.com"},
+			Status: "pending_validation",
+		},
+	})
+
+	assert.Equal(t, []certificate{
+		{
+			ID:        "pack",
+			ZoneID:    "zone",
+			Type:      certificateTypeCertificatePack,
+			Hosts:     []string{"example.com", "*.example.com"},
+			Issuer:    "LetsEncrypt ECDSA",
+			Authority: "lets_encrypt",
+			Status:    "active",
+			ExpiresOn: soon,
+		},
+		{
+			ID:     "pending",
+			ZoneID: "zone",
+			Type:   certificateTypeCertificatePack,
+			Hosts:  []string{"pending.example.com"},
+			Status: "pending_validation",
+		},
+	}, certificates)
+}
+
+func TestCustomHostnameCertificates(t *testing.T) {
+	expiry := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	certificates := customHostnameCertificates("zone", []cloudflare.CustomHostname{
+		{ID: "no-ssl", Hostname: "no-ssl.example.com"},
+		{
+			ID:       "managed",
+			Hostname: "managed.example.com",
+			SSL: &cloudflare.CustomHostnameSSL{
+				ID:                   "managed-ssl",
+				Status:               "active",
+				CertificateAuthority: "google",
+				Certificates: []cloudflare.CustomHostnameSSLCertificates{
+					{Issuer: "GoogleTrustServices", ExpiresOn: &expiry},
+				},
+			},
+		},
+		{
+			ID:       "custom",
+			Hostname: "custom.example.com",
+			SSL: &cloudflare.CustomHostnameSSL{
+				Status:               "pending_deployment",
+				CertificateAuthority: "lets_encrypt",
+				CustomCertificate:    "-----BEGIN CERTIFICATE-----",
+			},
+		},
+	})
+
+	if assert.Len(t, certificates, 2) {
+		assert.Equal(t, "managed-ssl", certificates[0].ID)
+		assert.Equal(t, []string{"managed.example.com"}, certificates[0].Hosts)
+		assert.Equal(t, "GoogleTrustServices", certificates[0].Issuer)
+		assert.Equal(t, "google", certificates[0].Authority)
+		assert.Equal(t, expiry, certificates[0].ExpiresOn)
+
+		assert.Equal(t, "custom", certificates[1].ID)
+		assert.Empty(t, certificates[1].Authority)
+		assert.True(t, certificates[1].ExpiresOn.IsZero())
+	}
+}
+
+func TestOriginCACertificates(t *testing.T) {
+	expiry := time.Now().AddDate(1, 0, 0).Truncate(time.Second)
+	cert, _, err := utils.GenerateEphemeralCertAndKey([]string{"origin.example.com"}, expiry)
+	require.NoError(t, err)
+	chain, err := utils.ParseCertificateChain(cert)
+	require.NoError(t, err)
+
+	certificates := originCACertificates("zone", []cloudflare.OriginCACertificate{
+		{ID: "active", Certificate: cert, Hostnames: []string{"origin.example.com"}, ExpiresOn: expiry},
+		{ID: "revoked", Hostnames: []string{"old.example.com"}, ExpiresOn: expiry, RevokedAt: time.Now()},
+	})
+
+	if assert.Len(t, certificates, 2) {
+		assert.Equal(t, "active", certificates[0].Status)
+		assert.Equal(t, chain[0].Issuer.CommonName, certificates[0].Issuer)
+		assert.Equal(t, "cloudflare", certificates[0].Authority)
+		assert.Equal(t, "revoked", certificates[1].Status)
+		assert.Empty(t, certificates[1].Issuer)
+	}
+}
+
+func TestFilterCertificates(t *testing.T) {
+	now := time.Now()
+	certificates := []certificate{
+		{ID: "pending"},
+		{ID: "later", ExpiresOn: now.AddDate(0, 0, 60)},
+		{ID: "b", ExpiresOn: now.AddDate(0, 0, 10)},
+		{ID: "a", ExpiresOn: now.AddDate(0, 0, 10)},
+	}
+
+	ids := func(certificates []certificate) []string {
+		result := make([]string, 0, len(certificates))
+		for _, c := range certificates {
+			result = append(result, c.ID)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"a", "b", "later", "pending"}, ids(filterCertificates(certificates, time.Time{})))
+	assert.Equal(t, []string{"a", "b"}, ids(filterCertificates(certificates, now.AddDate(0, 0, 30))))
+}
@@ -0,0 +1,27 @@
+package certificates
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type CertificatesModel struct {
+	AccountID    types.String             `tfsdk:"account_id"`
+	ZoneID       types.String             `tfsdk:"zone_id"`
+	ID           types.String             `tfsdk:"id"`
+	Filter       *CertificatesFilterModel `tfsdk:"filter"`
+	Certificates []*CertificateModel      `tfsdk:"certificates"`
+}
+
+type CertificatesFilterModel struct {
+	Types             []types.String `tfsdk:"types"`
+	ExpiresWithinDays types.Int64    `tfsdk:"expires_within_days"`
+}
+
+type CertificateModel struct {
+	ID        types.String   `tfsdk:"id"`
+	ZoneID    types.String   `tfsdk:"zone_id"`
+	Type      types.String   `tfsdk:"type"`
+	Hosts     []types.String `tfsdk:"hosts"`
+	Issuer    types.String   `tfsdk:"issuer"`
+	Authority types.String   `tfsdk:"authority"`
+	Status    types.String   `tfsdk:"status"`
+	ExpiresOn types.String   `tfsdk:"expires_on"`
+}
@@ -0,0 +1,111 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *CertificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Use this data source to list the TLS certificates of a zone, or of
+			every zone in an account, in a single shape. Certificate packs,
+			custom SSL certificates, custom hostname certificates and Origin CA
+			certificates are included.
+		`),
+		Attributes: map[string]schema.Attribute{
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: "The account identifier to list the certificates of all zones for. Must provide only one of `zone_id`, `account_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.Expression(path.MatchRoot(consts.ZoneIDSchemaKey)),
+					),
+				},
+			},
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: "The zone identifier to list the certificates for. Must provide only one of `zone_id`, `account_id`.",
+				Optional:            true,
+			},
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+			},
+			"certificates": schema.ListNestedAttribute{
+				MarkdownDescription: "The certificates matching the filter, ordered by expiry with the soonest expiring first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the certificate, or of the certificate pack.",
+						},
+						"zone_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The zone the certificate belongs to.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: fmt.Sprintf("The kind of certificate. %s", utils.RenderAvailableDocumentationValuesStringSlice(certificateTypes)),
+						},
+						"hosts": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The hostnames covered by the certificate.",
+						},
+						"issuer": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The issuer of the certificate.",
+						},
+						"authority": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The certificate authority which issued the certificate on Cloudflare's behalf. Empty for certificates uploaded to Cloudflare.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the certificate.",
+						},
+						"expires_on": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the certificate expires. Empty until a certificate has been issued.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "One or more values used to look up certificates. If more than one value is given all values must match in order to be included.",
+				Attributes: map[string]schema.Attribute{
+					"types": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: fmt.Sprintf("The kinds of certificate to include. Only the APIs for these kinds are queried. %s", utils.RenderAvailableDocumentationValuesStringSlice(certificateTypes)),
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(certificateTypes...)),
+						},
+					},
+					"expires_within_days": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Only include certificates expiring within this many days. Certificates which have not been issued yet are excluded.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
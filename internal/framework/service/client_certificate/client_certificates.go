@@ -0,0 +1,129 @@
+package client_certificate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// clientCertificate is a client certificate issued by the zone's Cloudflare
+// managed certificate authority.
+type clientCertificate struct {
+	ID                   string `json:"id"`
+	Certificate          string `json:"certificate"`
+	CertificateAuthority struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"certificate_authority"`
+	CommonName        string `json:"common_name"`
+	CSR               string `json:"csr"`
+	SerialNumber      string `json:"serial_number"`
+	FingerprintSHA256 string `json:"fingerprint_sha256"`
+	IssuedOn          string `json:"issued_on"`
+	ExpiresOn         string `json:"expires_on"`
+	Status            string `json:"status"`
+	ValidityDays      int    `json:"validity_days"`
+}
+
+// clientCertificateRevoked reports whether a certificate with the given
+// status is revoked or being revoked.
+func clientCertificateRevoked(status string) bool {
+	return status == "revoked" || status == "pending_revocation"
+}
+
+func createClientCertificate(ctx context.Context, client *cloudflare.API, zoneID, csr string, validityDays int) (clientCertificate, error) {
+	params := map[string]interface{}{
+		"csr":           csr,
+		"validity_days": validityDays,
+	}
+
+	return doClientCertificateRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/client_certificates", zoneID), params)
+}
+
+func getClientCertificate(ctx context.Context, client *cloudflare.API, zoneID, certificateID string) (clientCertificate, error) {
+	return doClientCertificateRequest(ctx, client, http.MethodGet, clientCertificateURI(zoneID, certificateID), nil)
+}
+
+// revokeClientCertificate revokes the certificate. Client certificates can't
+// be deleted, revoking them is the closest equivalent.
+func revokeClientCertificate(ctx context.Context, client *cloudflare.API, zoneID, certificateID string) (clientCertificate, error) {
+	return doClientCertificateRequest(ctx, client, http.MethodDelete, clientCertificateURI(zoneID, certificateID), nil)
+}
+
+// reactivateClientCertificate reactivates a revoked certificate.
+func reactivateClientCertificate(ctx context.Context, client *cloudflare.API, zoneID, certificateID string) (clientCertificate, error) {
+	return doClientCertificateRequest(ctx, client, http.MethodPatch, clientCertificateURI(zoneID, certificateID), nil)
+}
+
+func doClientCertificateRequest(ctx context.Context, client *cloudflare.API, method, uri string, params interface{}) (clientCertificate, error) {
+	var certificate clientCertificate
+
+	res, err := client.Raw(ctx, method, uri, params, nil)
+	if err != nil {
+		return certificate, err
+	}
+
+	err = json.Unmarshal(res.Result, &certificate)
+
+	return certificate, err
+}
+
+func clientCertificateURI(zoneID, certificateID string) string {
+	return fmt.Sprintf("/zones/%s/client_certificates/%s", zoneID, certificateID)
+}
+
+// hostnameAssociations are the hostnames on which client certificates
+// issued by a certificate authority are validated.
+type hostnameAssociations struct {
+	Hostnames         []string `json:"hostnames"`
+	MTLSCertificateID string   `json:"mtls_certificate_id,omitempty"`
+}
+
+// getHostnameAssociations lists the hostnames associated with an uploaded
+// mTLS certificate, or with the Cloudflare managed certificate authority when
+// no certificate ID is given.
+func getHostnameAssociations(ctx context.Context, client *cloudflare.API, zoneID, mtlsCertificateID string) ([]string, error) {
+	uri := hostnameAssociationsURI(zoneID)
+	if mtlsCertificateID != "" {
+		uri += "?" + url.Values{"mtls_certificate_id": {mtlsCertificateID}}.Encode()
+	}
+
+	res, err := client.Raw(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var associations hostnameAssociations
+	err = json.Unmarshal(res.Result, &associations)
+
+	return associations.Hostnames, err
+}
+
+// replaceHostnameAssociations replaces every hostname associated with the
+// certificate authority.
+func replaceHostnameAssociations(ctx context.Context, client *cloudflare.API, zoneID, mtlsCertificateID string, hostnames []string) ([]string, error) {
+	if hostnames == nil {
+		hostnames = []string{}
+	}
+
+	res, err := client.Raw(ctx, http.MethodPut, hostnameAssociationsURI(zoneID), hostnameAssociations{
+		Hostnames:         hostnames,
+		MTLSCertificateID: mtlsCertificateID,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var associations hostnameAssociations
+	err = json.Unmarshal(res.Result, &associations)
+
+	return associations.Hostnames, err
+}
+
+func hostnameAssociationsURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/certificate_authorities/hostname_associations", zoneID)
+}
@@ -0,0 +1,23 @@
+package client_certificate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCertificateRevoked(t *testing.T) {
+	for status, revoked := range map[string]bool{
+		"active":               false,
+		"pending_reactivation": false,
+		"pending_revocation":   true,
+		"revoked":              true,
+	} {
+		assert.Equal(t, revoked, clientCertificateRevoked(status), status)
+	}
+}
+
+func TestHostnamesResourceID(t *testing.T) {
+	assert.Equal(t, "0da42c8d2132a9ddaf714f9e7c920711", hostnamesResourceID("0da42c8d2132a9ddaf714f9e7c920711", ""))
+	assert.Equal(t, "0da42c8d2132a9ddaf714f9e7c920711/2458ce5a", hostnamesResourceID("0da42c8d2132a9ddaf714f9e7c920711", "2458ce5a"))
+}
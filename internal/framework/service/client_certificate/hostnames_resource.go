@@ -0,0 +1,174 @@
+package client_certificate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClientCertificateHostnamesResource{}
+var _ resource.ResourceWithImportState = &ClientCertificateHostnamesResource{}
+
+func NewHostnamesResource() resource.Resource {
+	return &ClientCertificateHostnamesResource{}
+}
+
+// ClientCertificateHostnamesResource defines the resource implementation.
+type ClientCertificateHostnamesResource struct {
+	client *cloudflare.API
+}
+
+func (r *ClientCertificateHostnamesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_certificate_hostnames"
+}
+
+func (r *ClientCertificateHostnamesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClientCertificateHostnamesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ClientCertificateHostnamesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.replaceHostnames(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(hostnamesResourceID(data.ZoneID.ValueString(), data.MTLSCertificateID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientCertificateHostnamesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ClientCertificateHostnamesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostnames, err := getHostnameAssociations(ctx, r.client, data.ZoneID.ValueString(), data.MTLSCertificateID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("client certificate hostnames %s no longer exist", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read client certificate hostnames", err.Error())
+		return
+	}
+
+	set, diags := types.SetValueFrom(ctx, types.StringType, hostnames)
+	resp.Diagnostics.Append(diags...)
+	data.Hostnames = set
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientCertificateHostnamesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ClientCertificateHostnamesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.replaceHostnames(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientCertificateHostnamesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ClientCertificateHostnamesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := replaceHostnameAssociations(ctx, r.client, data.ZoneID.ValueString(), data.MTLSCertificateID.ValueString(), nil); err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return
+		}
+		resp.Diagnostics.AddError("failed to delete client certificate hostnames", err.Error())
+	}
+}
+
+func (r *ClientCertificateHostnamesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) > 2 {
+		resp.Diagnostics.AddError("error splitting import ID", "invalid ID specified. Please specify the ID as \"<zone_id>\" or \"<zone_id>/<mtls_certificate_id>\"")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.ZoneIDSchemaKey), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.IDSchemaKey), req.ID)...)
+	if len(idParts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mtls_certificate_id"), idParts[1])...)
+	}
+}
+
+// replaceHostnames replaces the associated hostnames with the planned ones.
+func (r *ClientCertificateHostnamesResource) replaceHostnames(ctx context.Context, data *ClientCertificateHostnamesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var hostnames []string
+	diags.Append(data.Hostnames.ElementsAs(ctx, &hostnames, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := replaceHostnameAssociations(ctx, r.client, data.ZoneID.ValueString(), data.MTLSCertificateID.ValueString(), hostnames); err != nil {
+		diags.AddError("failed to update client certificate hostnames", err.Error())
+	}
+
+	return diags
+}
+
+// hostnamesResourceID identifies the hostnames of a certificate authority,
+// the zone ID alone stands for the Cloudflare managed authority.
+func hostnamesResourceID(zoneID, mtlsCertificateID string) string {
+	if mtlsCertificateID == "" {
+		return zoneID
+	}
+
+	return zoneID + "/" + mtlsCertificateID
+}
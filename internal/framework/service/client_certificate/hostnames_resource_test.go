@@ -0,0 +1,63 @@
+package client_certificate_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudflareClientCertificateHostnames_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_client_certificate_hostnames." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareClientCertificateHostnamesConfig(rnd, zoneID, fmt.Sprintf("api.%s", domain)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "hostnames.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "hostnames.*", fmt.Sprintf("api.%s", domain)),
+				),
+			},
+			{
+				Config: testAccCloudflareClientCertificateHostnamesConfig(rnd, zoneID, fmt.Sprintf("api.%s", domain), fmt.Sprintf("mtls.%s", domain)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hostnames.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "hostnames.*", fmt.Sprintf("mtls.%s", domain)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     zoneID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareClientCertificateHostnamesConfig(rnd, zoneID string, hostnames ...string) string {
+	quoted := make([]string, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		quoted = append(quoted, fmt.Sprintf("%q", hostname))
+	}
+
+	return fmt.Sprintf(`
+resource "cloudflare_client_certificate_hostnames" "%[1]s" {
+  zone_id   = "%[2]s"
+  hostnames = [%[3]s]
+}`, rnd, zoneID, strings.Join(quoted, ", "))
+}
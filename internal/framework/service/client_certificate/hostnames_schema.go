@@ -0,0 +1,51 @@
+package client_certificate
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *ClientCertificateHostnamesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare resource to manage the hostnames of a zone
+			on which client certificates are validated. Without an
+			` + "`mtls_certificate_id`" + ` the hostnames apply to certificates
+			issued with ` + "`cloudflare_client_certificate`" + `.
+
+			The resource manages every hostname associated with the
+			certificate authority, the associations are removed when the
+			resource is destroyed.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: computedStringAttribute(consts.IDSchemaDescription),
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mtls_certificate_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of an uploaded mTLS certificate authority, see `cloudflare_mtls_certificate`. Defaults to the Cloudflare managed certificate authority.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostnames": schema.SetAttribute{
+				MarkdownDescription: "The hostnames on which client certificates issued by the certificate authority are validated.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package client_certificate
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ClientCertificateModel struct {
+	ZoneID                 types.String `tfsdk:"zone_id"`
+	ID                     types.String `tfsdk:"id"`
+	CSR                    types.String `tfsdk:"csr"`
+	ValidityDays           types.Int64  `tfsdk:"validity_days"`
+	Revoked                types.Bool   `tfsdk:"revoked"`
+	Certificate            types.String `tfsdk:"certificate"`
+	CertificateAuthorityID types.String `tfsdk:"certificate_authority_id"`
+	CommonName             types.String `tfsdk:"common_name"`
+	SerialNumber           types.String `tfsdk:"serial_number"`
+	FingerprintSHA256      types.String `tfsdk:"fingerprint_sha256"`
+	IssuedOn               types.String `tfsdk:"issued_on"`
+	ExpiresOn              types.String `tfsdk:"expires_on"`
+	Status                 types.String `tfsdk:"status"`
+}
+
+type ClientCertificateHostnamesModel struct {
+	ZoneID            types.String `tfsdk:"zone_id"`
+	ID                types.String `tfsdk:"id"`
+	MTLSCertificateID types.String `tfsdk:"mtls_certificate_id"`
+	Hostnames         types.Set    `tfsdk:"hostnames"`
+}
@@ -0,0 +1,214 @@
+package client_certificate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClientCertificateResource{}
+var _ resource.ResourceWithImportState = &ClientCertificateResource{}
+var _ resource.ResourceWithValidateConfig = &ClientCertificateResource{}
+
+func NewResource() resource.Resource {
+	return &ClientCertificateResource{}
+}
+
+// ClientCertificateResource defines the resource implementation.
+type ClientCertificateResource struct {
+	client *cloudflare.API
+}
+
+func (r *ClientCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_certificate"
+}
+
+func (r *ClientCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClientCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var csr types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("csr"), &csr)...)
+
+	if resp.Diagnostics.HasError() || csr.IsNull() || csr.IsUnknown() {
+		return
+	}
+
+	if _, err := utils.ParseCertificateRequest(csr.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("csr"), "invalid certificate signing request", err.Error())
+	}
+}
+
+func (r *ClientCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ClientCertificateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	certificate, err := createClientCertificate(ctx, r.client, zoneID, data.CSR.ValueString(), int(data.ValidityDays.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create client certificate", err.Error())
+		return
+	}
+
+	revoke := data.Revoked.ValueBool()
+	if revoke {
+		revoked, err := revokeClientCertificate(ctx, r.client, zoneID, certificate.ID)
+		if err != nil {
+			// An error would taint the certificate which was just issued, it
+			// is kept instead and revoked on the next apply.
+			resp.Diagnostics.AddWarning("failed to revoke client certificate", err.Error())
+		} else {
+			certificate = revoked
+		}
+	}
+
+	setClientCertificate(data, certificate)
+	data.Revoked = types.BoolValue(revoke)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ClientCertificateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate, err := getClientCertificate(ctx, r.client, data.ZoneID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("client certificate %s no longer exists", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read client certificate", err.Error())
+		return
+	}
+
+	// The CSR isn't changed when the certificate is imported so that the
+	// configured value doesn't need to match the API's formatting.
+	if data.CSR.IsNull() {
+		data.CSR = types.StringValue(certificate.CSR)
+	}
+	if certificate.ValidityDays != 0 {
+		data.ValidityDays = types.Int64Value(int64(certificate.ValidityDays))
+	}
+
+	setClientCertificate(data, certificate)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ClientCertificateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only `revoked` can change in place.
+	zoneID, certificateID := data.ZoneID.ValueString(), state.ID.ValueString()
+
+	var certificate clientCertificate
+	var err error
+	if data.Revoked.ValueBool() {
+		certificate, err = revokeClientCertificate(ctx, r.client, zoneID, certificateID)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to revoke client certificate", err.Error())
+			return
+		}
+	} else {
+		certificate, err = reactivateClientCertificate(ctx, r.client, zoneID, certificateID)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to reactivate client certificate", err.Error())
+			return
+		}
+	}
+
+	setClientCertificate(data, certificate)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ClientCertificateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if clientCertificateRevoked(data.Status.ValueString()) {
+		tflog.Info(ctx, fmt.Sprintf("client certificate %s is already revoked", data.ID.ValueString()))
+		return
+	}
+
+	if _, err := revokeClientCertificate(ctx, r.client, data.ZoneID.ValueString(), data.ID.ValueString()); err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return
+		}
+		resp.Diagnostics.AddError("failed to revoke client certificate", err.Error())
+	}
+}
+
+func (r *ClientCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError("error splitting import ID", "invalid ID specified. Please specify the ID as \"<zone_id>/<client_certificate_id>\"")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.ZoneIDSchemaKey), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.IDSchemaKey), idParts[1])...)
+}
+
+func setClientCertificate(data *ClientCertificateModel, certificate clientCertificate) {
+	data.ID = types.StringValue(certificate.ID)
+	data.Revoked = types.BoolValue(clientCertificateRevoked(certificate.Status))
+	data.Certificate = types.StringValue(certificate.Certificate)
+	data.CertificateAuthorityID = types.StringValue(certificate.CertificateAuthority.ID)
+	data.CommonName = types.StringValue(certificate.CommonName)
+	data.SerialNumber = types.StringValue(certificate.SerialNumber)
+	data.FingerprintSHA256 = types.StringValue(certificate.FingerprintSHA256)
+	data.IssuedOn = types.StringValue(certificate.IssuedOn)
+	data.ExpiresOn = types.StringValue(certificate.ExpiresOn)
+	data.Status = types.StringValue(certificate.Status)
+}
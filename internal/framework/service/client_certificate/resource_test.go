@@ -0,0 +1,95 @@
+package client_certificate_test
+
+import (
+	"crypto/x509/pkix"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var revokedStatus = regexp.MustCompile("^(pending_revocation|revoked)$")
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func testAccClientCertificateRequest(t *testing.T, commonName string) string {
+	key, err := utils.GeneratePrivateKey(utils.KeyAlgorithmECDSA, 0, "P256")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csr, err := utils.GenerateCertificateRequest(key, pkix.Name{CommonName: commonName, Organization: []string{"Terraform Acceptance Tests"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return csr
+}
+
+func TestAccCloudflareClientCertificate_RevokeAndReactivate(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_client_certificate." + rnd
+	csr := testAccClientCertificateRequest(t, rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareClientCertificateConfig(rnd, zoneID, csr, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "validity_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "revoked", "false"),
+					resource.TestCheckResourceAttr(resourceName, "common_name", rnd),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial_number"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_on"),
+				),
+			},
+			{
+				Config: testAccCloudflareClientCertificateConfig(rnd, zoneID, csr, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "revoked", "true"),
+					resource.TestMatchResourceAttr(resourceName, "status", revokedStatus),
+				),
+			},
+			{
+				Config: testAccCloudflareClientCertificateConfig(rnd, zoneID, csr, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "revoked", "false"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/%s", zoneID, s.RootModule().Resources[resourceName].Primary.ID), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"csr", "status"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareClientCertificateConfig(rnd, zoneID, csr string, revoked bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_client_certificate" "%[1]s" {
+  zone_id       = "%[2]s"
+  csr           = %[3]q
+  validity_days = 30
+  revoked       = %[4]t
+}`, rnd, zoneID, csr, revoked)
+}
@@ -0,0 +1,88 @@
+package client_certificate
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func computedStringAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *ClientCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare resource to issue client certificates from
+			the zone's Cloudflare managed certificate authority, for use with
+			API Shield mTLS. The hostnames on which the certificates are
+			validated are managed with ` + "`cloudflare_client_certificate_hostnames`" + `.
+
+			Client certificates can't be deleted, destroying the resource
+			revokes the certificate.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: computedStringAttribute(consts.IDSchemaDescription),
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"csr": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded certificate signing request to issue the certificate for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"validity_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days the certificate is valid for. Defaults to `3650`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3650),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 3650),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"revoked": schema.BoolAttribute{
+				MarkdownDescription: "Whether the certificate is revoked. Changing this revokes or reactivates the certificate in place. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"certificate":              computedStringAttribute("The PEM encoded certificate issued for the certificate signing request."),
+			"certificate_authority_id": computedStringAttribute("The identifier of the certificate authority that issued the certificate."),
+			"common_name":              computedStringAttribute("The common name of the certificate."),
+			"serial_number":            computedStringAttribute("The serial number of the certificate."),
+			"fingerprint_sha256":       computedStringAttribute("The SHA-256 fingerprint of the certificate."),
+			"issued_on":                computedStringAttribute("When the certificate was issued."),
+			"expires_on":               computedStringAttribute("When the certificate expires."),
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the certificate.",
+				Computed:            true,
+			},
+		},
+	}
+}
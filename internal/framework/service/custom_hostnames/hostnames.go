@@ -0,0 +1,441 @@
+package custom_hostnames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// customHostnamePollInterval is how often the pending hostnames are fetched
+// while waiting for them to become active.
+const customHostnamePollInterval = 15 * time.Second
+
+// hostnameOperations are the API calls needed to move the hostnames from the
+// prior state to the plan, each sorted by hostname.
+type hostnameOperations struct {
+	Create []string
+	Update []string
+	Delete []string
+}
+
+// planHostnameOperations compares the configurable attributes of the planned
+// hostnames to the prior state.
+func planHostnameOperations(plan, state map[string]*CustomHostnameModel) hostnameOperations {
+	var ops hostnameOperations
+
+	for hostname, planned := range plan {
+		prior, ok := state[hostname]
+		switch {
+		case !ok || prior == nil || prior.ID.IsNull() || prior.ID.ValueString() == "":
+			ops.Create = append(ops.Create, hostname)
+		case !customHostnameConfigEqual(planned, prior):
+			ops.Update = append(ops.Update, hostname)
+		}
+	}
+
+	for hostname, prior := range state {
+		if _, ok := plan[hostname]; !ok && prior != nil && prior.ID.ValueString() != "" {
+			ops.Delete = append(ops.Delete, hostname)
+		}
+	}
+
+	sort.Strings(ops.Create)
+	sort.Strings(ops.Update)
+	sort.Strings(ops.Delete)
+
+	return ops
+}
+
+// customHostnameConfigEqual reports whether two hostnames have the same
+// configurable attributes, ignoring everything computed by the API.
+func customHostnameConfigEqual(a, b *CustomHostnameModel) bool {
+	if !a.CustomOriginServer.Equal(b.CustomOriginServer) ||
+		!a.CustomOriginSNI.Equal(b.CustomOriginSNI) ||
+		!a.CustomMetadata.Equal(b.CustomMetadata) {
+		return false
+	}
+
+	if a.SSL == nil || b.SSL == nil {
+		return a.SSL == nil && b.SSL == nil
+	}
+
+	if !a.SSL.Method.Equal(b.SSL.Method) ||
+		!a.SSL.Type.Equal(b.SSL.Type) ||
+		!a.SSL.BundleMethod.Equal(b.SSL.BundleMethod) ||
+		!a.SSL.CertificateAuthority.Equal(b.SSL.CertificateAuthority) ||
+		!a.SSL.Wildcard.Equal(b.SSL.Wildcard) {
+		return false
+	}
+
+	if a.SSL.Settings == nil || b.SSL.Settings == nil {
+		return a.SSL.Settings == nil && b.SSL.Settings == nil
+	}
+
+	return a.SSL.Settings.HTTP2.Equal(b.SSL.Settings.HTTP2) &&
+		a.SSL.Settings.TLS13.Equal(b.SSL.Settings.TLS13) &&
+		a.SSL.Settings.MinTLSVersion.Equal(b.SSL.Settings.MinTLSVersion) &&
+		a.SSL.Settings.Ciphers.Equal(b.SSL.Settings.Ciphers) &&
+		a.SSL.Settings.EarlyHints.Equal(b.SSL.Settings.EarlyHints)
+}
+
+// copyComputedAttributes copies the attributes computed by the API from one
+// hostname to another.
+func copyComputedAttributes(dst, src *CustomHostnameModel) {
+	dst.ID = src.ID
+	dst.Status = src.Status
+	dst.SSLStatus = src.SSLStatus
+	dst.OwnershipVerificationName = src.OwnershipVerificationName
+	dst.OwnershipVerificationValue = src.OwnershipVerificationValue
+	dst.ValidationRecords = src.ValidationRecords
+	dst.VerificationErrors = src.VerificationErrors
+}
+
+// markComputedAttributesUnknown marks the attributes computed by the API as
+// unknown for hostnames that are created or updated.
+func markComputedAttributesUnknown(m *CustomHostnameModel) {
+	if m.ID.IsNull() || m.ID.ValueString() == "" {
+		m.ID = types.StringUnknown()
+	}
+	m.Status = types.StringUnknown()
+	m.SSLStatus = types.StringUnknown()
+	m.OwnershipVerificationName = types.StringUnknown()
+	m.OwnershipVerificationValue = types.StringUnknown()
+	m.ValidationRecords = types.ListUnknown(types.ObjectType{AttrTypes: validationRecordAttrTypes})
+	m.VerificationErrors = types.ListUnknown(types.StringType)
+}
+
+// listCustomHostnames fetches every custom hostname of the zone, keyed by
+// hostname.
+func listCustomHostnames(ctx context.Context, client *cloudflare.API, zoneID string) (map[string]cloudflare.CustomHostname, error) {
+	result := make(map[string]cloudflare.CustomHostname)
+
+	for page := 1; ; page++ {
+		hostnames, resultInfo, err := client.CustomHostnames(ctx, zoneID, page, cloudflare.CustomHostname{})
+		if err != nil {
+			return nil, fmt.Errorf("error listing custom hostnames for zone %q: %w", zoneID, err)
+		}
+
+		for _, ch := range hostnames {
+			result[ch.Hostname] = ch
+		}
+
+		if len(hostnames) == 0 || page >= resultInfo.TotalPages {
+			return result, nil
+		}
+	}
+}
+
+// waitForCustomHostnamesActive polls the given hostnames, starting from the
+// version returned when they were created or updated, until they and their
+// certificates are active or the timeout expires. Only the hostnames which
+// are still pending are fetched, up to size at a time. The latest known
+// version of the hostnames is returned in both cases.
+func waitForCustomHostnamesActive(ctx context.Context, client *cloudflare.API, zoneID string, hostnames map[string]cloudflare.CustomHostname, size int, timeout time.Duration) (map[string]cloudflare.CustomHostname, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	latest := make(map[string]cloudflare.CustomHostname, len(hostnames))
+	for hostname, ch := range hostnames {
+		latest[hostname] = ch
+	}
+
+	for {
+		var pending, statuses []string
+		for hostname, ch := range latest {
+			if !customHostnameActive(ch) {
+				pending = append(pending, hostname)
+			}
+		}
+		if len(pending) == 0 {
+			return latest, nil
+		}
+		sort.Strings(pending)
+		for _, hostname := range pending {
+			statuses = append(statuses, customHostnamePendingStatus(latest[hostname]))
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("waiting for %d custom hostnames to become active", len(pending)))
+
+		select {
+		case <-ctx.Done():
+			return latest, fmt.Errorf("timed out waiting for custom hostnames to become active: %s", strings.Join(statuses, "; "))
+		case <-time.After(customHostnamePollInterval):
+		}
+
+		ids := make(map[string]string, len(pending))
+		for _, hostname := range pending {
+			ids[hostname] = latest[hostname].ID
+		}
+
+		var mu sync.Mutex
+		errs := utils.ApplyInBatches(ctx, pending, size, func(ctx context.Context, hostname string) error {
+			ch, err := client.CustomHostname(ctx, zoneID, ids[hostname])
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			latest[hostname] = ch
+			mu.Unlock()
+
+			return nil
+		})
+
+		for _, hostname := range pending {
+			err, ok := errs[hostname]
+			if !ok {
+				continue
+			}
+
+			var notFoundError *cloudflare.NotFoundError
+			switch {
+			case errors.As(err, &notFoundError):
+				return latest, fmt.Errorf("custom hostname %q no longer exists", hostname)
+			case errors.Is(ctx.Err(), context.DeadlineExceeded):
+				return latest, fmt.Errorf("timed out waiting for custom hostnames to become active: %w", err)
+			default:
+				return latest, fmt.Errorf("error finding custom hostname %q: %w", hostname, err)
+			}
+		}
+	}
+}
+
+func customHostnameActive(ch cloudflare.CustomHostname) bool {
+	return ch.Status == cloudflare.ACTIVE && (ch.SSL == nil || ch.SSL.Status == string(cloudflare.ACTIVE))
+}
+
+// customHostnamePendingStatus describes why a hostname isn't active yet.
+func customHostnamePendingStatus(ch cloudflare.CustomHostname) string {
+	description := fmt.Sprintf("%s has status %q", ch.Hostname, ch.Status)
+	if ch.SSL != nil {
+		description += fmt.Sprintf(" and certificate status %q", ch.SSL.Status)
+	}
+
+	var problems []string
+	problems = append(problems, ch.VerificationErrors...)
+	if ch.SSL != nil {
+		for _, validationError := range ch.SSL.ValidationErrors {
+			problems = append(problems, validationError.Message)
+		}
+	}
+	if len(problems) > 0 {
+		description += fmt.Sprintf(" (%s)", strings.Join(problems, ", "))
+	}
+
+	return description
+}
+
+// buildCustomHostname converts the configurable attributes of a hostname to
+// the API representation.
+func buildCustomHostname(ctx context.Context, hostname string, m *CustomHostnameModel) (cloudflare.CustomHostname, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ch := cloudflare.CustomHostname{
+		Hostname:           hostname,
+		CustomOriginServer: m.CustomOriginServer.ValueString(),
+		CustomOriginSNI:    m.CustomOriginSNI.ValueString(),
+	}
+
+	if !m.CustomMetadata.IsNull() && !m.CustomMetadata.IsUnknown() {
+		var values map[string]string
+		diags.Append(m.CustomMetadata.ElementsAs(ctx, &values, false)...)
+
+		metadata := make(cloudflare.CustomMetadata, len(values))
+		for k, v := range values {
+			metadata[k] = v
+		}
+		ch.CustomMetadata = &metadata
+	}
+
+	if m.SSL != nil {
+		ch.SSL = &cloudflare.CustomHostnameSSL{
+			Method:               m.SSL.Method.ValueString(),
+			Type:                 m.SSL.Type.ValueString(),
+			BundleMethod:         m.SSL.BundleMethod.ValueString(),
+			CertificateAuthority: m.SSL.CertificateAuthority.ValueString(),
+		}
+		if !m.SSL.Wildcard.IsNull() {
+			ch.SSL.Wildcard = cloudflare.BoolPtr(m.SSL.Wildcard.ValueBool())
+		}
+
+		if m.SSL.Settings != nil {
+			ch.SSL.Settings = cloudflare.CustomHostnameSSLSettings{
+				HTTP2:         m.SSL.Settings.HTTP2.ValueString(),
+				TLS13:         m.SSL.Settings.TLS13.ValueString(),
+				MinTLSVersion: m.SSL.Settings.MinTLSVersion.ValueString(),
+				EarlyHints:    m.SSL.Settings.EarlyHints.ValueString(),
+			}
+			if !m.SSL.Settings.Ciphers.IsNull() && !m.SSL.Settings.Ciphers.IsUnknown() {
+				diags.Append(m.SSL.Settings.Ciphers.ElementsAs(ctx, &ch.SSL.Settings.Ciphers, false)...)
+			}
+		}
+	}
+
+	return ch, diags
+}
+
+// setCustomHostnameComputed sets the attributes computed by the API.
+func setCustomHostnameComputed(ctx context.Context, m *CustomHostnameModel, ch cloudflare.CustomHostname) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.ID = types.StringValue(ch.ID)
+	m.Status = types.StringValue(string(ch.Status))
+	m.OwnershipVerificationName = types.StringValue(ch.OwnershipVerification.Name)
+	m.OwnershipVerificationValue = types.StringValue(ch.OwnershipVerification.Value)
+
+	var records []CustomHostnameValidationRecordModel
+	m.SSLStatus = types.StringValue("")
+	if ch.SSL != nil {
+		m.SSLStatus = types.StringValue(ch.SSL.Status)
+		for _, record := range ch.SSL.ValidationRecords {
+			records = append(records, CustomHostnameValidationRecordModel{
+				Cname:       types.StringValue(record.CnameName),
+				CnameTarget: types.StringValue(record.CnameTarget),
+				TxtName:     types.StringValue(record.TxtName),
+				TxtValue:    types.StringValue(record.TxtValue),
+				HTTPUrl:     types.StringValue(record.HTTPUrl),
+				HTTPBody:    types.StringValue(record.HTTPBody),
+			})
+		}
+	}
+
+	m.ValidationRecords, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: validationRecordAttrTypes}, records)
+	diags.Append(d...)
+
+	verificationErrors := ch.VerificationErrors
+	if verificationErrors == nil {
+		verificationErrors = []string{}
+	}
+	m.VerificationErrors, d = types.ListValueFrom(ctx, types.StringType, verificationErrors)
+	diags.Append(d...)
+
+	return diags
+}
+
+// refreshCustomHostname updates a managed hostname from the API. Optional
+// attributes are only refreshed when they are set, as the API fills in
+// defaults for the ones left out of the configuration.
+func refreshCustomHostname(ctx context.Context, m *CustomHostnameModel, ch cloudflare.CustomHostname) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.CustomOriginServer = refreshString(m.CustomOriginServer, ch.CustomOriginServer)
+	m.CustomOriginSNI = refreshString(m.CustomOriginSNI, ch.CustomOriginSNI)
+
+	if !m.CustomMetadata.IsNull() {
+		metadata, d := customMetadataValue(ch.CustomMetadata)
+		diags.Append(d...)
+		m.CustomMetadata = metadata
+	}
+
+	if m.SSL != nil && ch.SSL != nil {
+		m.SSL.Method = refreshString(m.SSL.Method, ch.SSL.Method)
+		m.SSL.Type = refreshString(m.SSL.Type, ch.SSL.Type)
+		m.SSL.BundleMethod = refreshString(m.SSL.BundleMethod, ch.SSL.BundleMethod)
+		m.SSL.CertificateAuthority = refreshString(m.SSL.CertificateAuthority, ch.SSL.CertificateAuthority)
+		if !m.SSL.Wildcard.IsNull() && ch.SSL.Wildcard != nil {
+			m.SSL.Wildcard = types.BoolValue(*ch.SSL.Wildcard)
+		}
+
+		if s := m.SSL.Settings; s != nil {
+			s.HTTP2 = refreshString(s.HTTP2, ch.SSL.Settings.HTTP2)
+			s.TLS13 = refreshString(s.TLS13, ch.SSL.Settings.TLS13)
+			s.MinTLSVersion = refreshString(s.MinTLSVersion, ch.SSL.Settings.MinTLSVersion)
+			s.EarlyHints = refreshString(s.EarlyHints, ch.SSL.Settings.EarlyHints)
+			if !s.Ciphers.IsNull() {
+				ciphers, d := types.SetValueFrom(ctx, types.StringType, ch.SSL.Settings.Ciphers)
+				diags.Append(d...)
+				s.Ciphers = ciphers
+			}
+		}
+	}
+
+	diags.Append(setCustomHostnameComputed(ctx, m, ch)...)
+
+	return diags
+}
+
+// customHostnameFromAPI builds the model of a hostname that isn't managed yet,
+// such as when the resource is imported.
+func customHostnameFromAPI(ctx context.Context, ch cloudflare.CustomHostname) (*CustomHostnameModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	m := &CustomHostnameModel{
+		CustomOriginServer: optionalString(ch.CustomOriginServer),
+		CustomOriginSNI:    optionalString(ch.CustomOriginSNI),
+		CustomMetadata:     types.MapNull(types.StringType),
+	}
+
+	if ch.CustomMetadata != nil && len(*ch.CustomMetadata) > 0 {
+		metadata, d := customMetadataValue(ch.CustomMetadata)
+		diags.Append(d...)
+		m.CustomMetadata = metadata
+	}
+
+	if ch.SSL != nil {
+		m.SSL = &CustomHostnameSSLModel{
+			Method:               optionalString(ch.SSL.Method),
+			Type:                 optionalString(ch.SSL.Type),
+			BundleMethod:         optionalString(ch.SSL.BundleMethod),
+			CertificateAuthority: optionalString(ch.SSL.CertificateAuthority),
+			Wildcard:             types.BoolPointerValue(ch.SSL.Wildcard),
+		}
+
+		settings := ch.SSL.Settings
+		if settings.HTTP2 != "" || settings.TLS13 != "" || settings.MinTLSVersion != "" || settings.EarlyHints != "" || len(settings.Ciphers) > 0 {
+			m.SSL.Settings = &CustomHostnameSSLSettingsModel{
+				HTTP2:         optionalString(settings.HTTP2),
+				TLS13:         optionalString(settings.TLS13),
+				MinTLSVersion: optionalString(settings.MinTLSVersion),
+				EarlyHints:    optionalString(settings.EarlyHints),
+				Ciphers:       types.SetNull(types.StringType),
+			}
+			if len(settings.Ciphers) > 0 {
+				ciphers, d := types.SetValueFrom(ctx, types.StringType, settings.Ciphers)
+				diags.Append(d...)
+				m.SSL.Settings.Ciphers = ciphers
+			}
+		}
+	}
+
+	diags.Append(setCustomHostnameComputed(ctx, m, ch)...)
+
+	return m, diags
+}
+
+func customMetadataValue(metadata *cloudflare.CustomMetadata) (types.Map, diag.Diagnostics) {
+	elements := make(map[string]attr.Value)
+	if metadata != nil {
+		for k, v := range *metadata {
+			elements[k] = types.StringValue(fmt.Sprint(v))
+		}
+	}
+
+	return types.MapValue(types.StringType, elements)
+}
+
+func refreshString(current types.String, value string) types.String {
+	if current.IsNull() {
+		return current
+	}
+
+	return types.StringValue(value)
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
@@ -0,0 +1,143 @@
+package custom_hostnames
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testHostname(id, origin string) *CustomHostnameModel {
+	return &CustomHostnameModel{
+		CustomOriginServer: types.StringValue(origin),
+		CustomOriginSNI:    types.StringNull(),
+		CustomMetadata:     types.MapNull(types.StringType),
+		SSL: &CustomHostnameSSLModel{
+			Method: types.StringValue("txt"),
+			Type:   types.StringValue("dv"),
+		},
+		ID: types.StringValue(id),
+	}
+}
+
+func TestPlanHostnameOperations(t *testing.T) {
+	state := map[string]*CustomHostnameModel{
+		"unchanged.example.com": testHostname("1", "origin.example.com"),
+		"changed.example.com":   testHostname("2", "origin.example.com"),
+		"removed.example.com":   testHostname("3", "origin.example.com"),
+		"failed.example.com":    testHostname("", "origin.example.com"),
+		"abandoned.example.com": testHostname("", "origin.example.com"),
+	}
+	plan := map[string]*CustomHostnameModel{
+		"unchanged.example.com": testHostname("", "origin.example.com"),
+		"changed.example.com":   testHostname("", "other.example.com"),
+		"failed.example.com":    testHostname("", "origin.example.com"),
+		"new-b.example.com":     testHostname("", "origin.example.com"),
+		"new-a.example.com":     testHostname("", "origin.example.com"),
+	}
+
+	ops := planHostnameOperations(plan, state)
+
+	assert.Equal(t, []string{"failed.example.com", "new-a.example.com", "new-b.example.com"}, ops.Create)
+	assert.Equal(t, []string{"changed.example.com"}, ops.Update)
+	assert.Equal(t, []string{"removed.example.com"}, ops.Delete)
+
+	ops = planHostnameOperations(nil, state)
+	assert.Empty(t, ops.Create)
+	assert.Empty(t, ops.Update)
+	assert.Equal(t, []string{"changed.example.com", "removed.example.com", "unchanged.example.com"}, ops.Delete)
+}
+
+func TestCustomHostnameConfigEqual(t *testing.T) {
+	a := testHostname("1", "origin.example.com")
+	b := testHostname("", "origin.example.com")
+	assert.True(t, customHostnameConfigEqual(a, b), "computed attributes must be ignored")
+
+	b.SSL.Settings = &CustomHostnameSSLSettingsModel{MinTLSVersion: types.StringValue("1.2")}
+	assert.False(t, customHostnameConfigEqual(a, b))
+
+	b.SSL = nil
+	assert.False(t, customHostnameConfigEqual(a, b))
+
+	a.SSL = nil
+	assert.True(t, customHostnameConfigEqual(a, b))
+
+	b.CustomOriginSNI = types.StringValue("sni.example.com")
+	assert.False(t, customHostnameConfigEqual(a, b))
+}
+
+func TestBuildCustomHostname(t *testing.T) {
+	ctx := context.Background()
+	m := testHostname("", "origin.example.com")
+	m.CustomMetadata = types.MapValueMust(types.StringType, map[string]attr.Value{"customer": types.StringValue("acme")})
+	m.SSL.Wildcard = types.BoolValue(false)
+	m.SSL.Settings = &CustomHostnameSSLSettingsModel{
+		MinTLSVersion: types.StringValue("1.2"),
+		Ciphers:       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ECDHE-RSA-AES128-GCM-SHA256")}),
+	}
+
+	ch, diags := buildCustomHostname(ctx, "app.example.com", m)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "app.example.com", ch.Hostname)
+	assert.Equal(t, "origin.example.com", ch.CustomOriginServer)
+	assert.Equal(t, cloudflare.CustomMetadata{"customer": "acme"}, *ch.CustomMetadata)
+	assert.Equal(t, "txt", ch.SSL.Method)
+	assert.Equal(t, false, *ch.SSL.Wildcard)
+	assert.Equal(t, "1.2", ch.SSL.Settings.MinTLSVersion)
+	assert.Equal(t, []string{"ECDHE-RSA-AES128-GCM-SHA256"}, ch.SSL.Settings.Ciphers)
+}
+
+func TestRefreshCustomHostnameOnlyRefreshesConfiguredAttributes(t *testing.T) {
+	ctx := context.Background()
+	m := testHostname("1", "origin.example.com")
+
+	diags := refreshCustomHostname(ctx, m, cloudflare.CustomHostname{
+		ID:                 "1",
+		Hostname:           "app.example.com",
+		CustomOriginServer: "moved.example.com",
+		CustomOriginSNI:    "sni.example.com",
+		Status:             cloudflare.ACTIVE,
+		SSL: &cloudflare.CustomHostnameSSL{
+			Status:       "pending_validation",
+			Method:       "txt",
+			Type:         "dv",
+			BundleMethod: "ubiquitous",
+			ValidationRecords: []cloudflare.SSLValidationRecord{
+				{TxtName: "_acme-challenge.app.example.com", TxtValue: "token"},
+			},
+		},
+	})
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "moved.example.com", m.CustomOriginServer.ValueString())
+	assert.True(t, m.CustomOriginSNI.IsNull())
+	assert.True(t, m.SSL.BundleMethod.IsNull())
+	assert.Equal(t, "active", m.Status.ValueString())
+	assert.Equal(t, "pending_validation", m.SSLStatus.ValueString())
+	assert.Len(t, m.ValidationRecords.Elements(), 1)
+	assert.Empty(t, m.VerificationErrors.Elements())
+}
+
+func TestCustomHostnamePendingStatus(t *testing.T) {
+	ch := cloudflare.CustomHostname{
+		Hostname:           "app.example.com",
+		Status:             cloudflare.PENDING,
+		VerificationErrors: []string{"custom hostname does not CNAME to this zone."},
+		SSL: &cloudflare.CustomHostnameSSL{
+			Status:           "pending_validation",
+			ValidationErrors: []cloudflare.SSLValidationError{{Message: "TXT record not found"}},
+		},
+	}
+
+	assert.False(t, customHostnameActive(ch))
+	assert.Equal(t, `app.example.com has status "pending" and certificate status "pending_validation" (custom hostname does not CNAME to this zone., TXT record not found)`, customHostnamePendingStatus(ch))
+
+	ch.Status = cloudflare.ACTIVE
+	ch.SSL.Status = "active"
+	assert.True(t, customHostnameActive(ch))
+}
@@ -0,0 +1,52 @@
+package custom_hostnames
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type CustomHostnamesModel struct {
+	ZoneID        types.String                    `tfsdk:"zone_id"`
+	ID            types.String                    `tfsdk:"id"`
+	Hostnames     map[string]*CustomHostnameModel `tfsdk:"hostnames"`
+	BatchSize     types.Int64                     `tfsdk:"batch_size"`
+	WaitForActive types.Bool                      `tfsdk:"wait_for_active"`
+	Timeout       types.String                    `tfsdk:"timeout"`
+}
+
+type CustomHostnameModel struct {
+	CustomOriginServer         types.String            `tfsdk:"custom_origin_server"`
+	CustomOriginSNI            types.String            `tfsdk:"custom_origin_sni"`
+	CustomMetadata             types.Map               `tfsdk:"custom_metadata"`
+	SSL                        *CustomHostnameSSLModel `tfsdk:"ssl"`
+	ID                         types.String            `tfsdk:"id"`
+	Status                     types.String            `tfsdk:"status"`
+	SSLStatus                  types.String            `tfsdk:"ssl_status"`
+	OwnershipVerificationName  types.String            `tfsdk:"ownership_verification_name"`
+	OwnershipVerificationValue types.String            `tfsdk:"ownership_verification_value"`
+	ValidationRecords          types.List              `tfsdk:"validation_records"`
+	VerificationErrors         types.List              `tfsdk:"verification_errors"`
+}
+
+type CustomHostnameSSLModel struct {
+	Method               types.String                    `tfsdk:"method"`
+	Type                 types.String                    `tfsdk:"type"`
+	BundleMethod         types.String                    `tfsdk:"bundle_method"`
+	CertificateAuthority types.String                    `tfsdk:"certificate_authority"`
+	Wildcard             types.Bool                      `tfsdk:"wildcard"`
+	Settings             *CustomHostnameSSLSettingsModel `tfsdk:"settings"`
+}
+
+type CustomHostnameSSLSettingsModel struct {
+	HTTP2         types.String `tfsdk:"http2"`
+	TLS13         types.String `tfsdk:"tls13"`
+	MinTLSVersion types.String `tfsdk:"min_tls_version"`
+	Ciphers       types.Set    `tfsdk:"ciphers"`
+	EarlyHints    types.String `tfsdk:"early_hints"`
+}
+
+type CustomHostnameValidationRecordModel struct {
+	Cname       types.String `tfsdk:"cname"`
+	CnameTarget types.String `tfsdk:"cname_target"`
+	TxtName     types.String `tfsdk:"txt_name"`
+	TxtValue    types.String `tfsdk:"txt_value"`
+	HTTPUrl     types.String `tfsdk:"http_url"`
+	HTTPBody    types.String `tfsdk:"http_body"`
+}
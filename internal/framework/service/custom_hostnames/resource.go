@@ -0,0 +1,380 @@
+package custom_hostnames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomHostnamesResource{}
+var _ resource.ResourceWithImportState = &CustomHostnamesResource{}
+var _ resource.ResourceWithModifyPlan = &CustomHostnamesResource{}
+var _ resource.ResourceWithValidateConfig = &CustomHostnamesResource{}
+
+func NewResource() resource.Resource {
+	return &CustomHostnamesResource{}
+}
+
+// CustomHostnamesResource defines the resource implementation.
+type CustomHostnamesResource struct {
+	client *cloudflare.API
+}
+
+func (r *CustomHostnamesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_hostnames"
+}
+
+func (r *CustomHostnamesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomHostnamesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var timeout types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &timeout)...)
+
+	if resp.Diagnostics.HasError() || timeout.IsNull() || timeout.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(timeout.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"invalid timeout",
+			fmt.Sprintf("%q is not a positive duration, use a value such as \"30m\" or \"2h\".", timeout.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan keeps the computed attributes of hostnames that don't change so
+// that only the hostnames being created or updated show up in the plan.
+func (r *CustomHostnamesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var hostnames types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("hostnames"), &hostnames)...)
+	if resp.Diagnostics.HasError() || hostnames.IsUnknown() {
+		return
+	}
+
+	var plan, state *CustomHostnamesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for hostname, planned := range plan.Hostnames {
+		var prior *CustomHostnameModel
+		if state != nil {
+			prior = state.Hostnames[hostname]
+		}
+
+		if prior != nil && prior.ID.ValueString() != "" && customHostnameConfigEqual(planned, prior) {
+			copyComputedAttributes(planned, prior)
+		} else {
+			markComputedAttributesUnknown(planned)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hostnames"), plan.Hostnames)...)
+}
+
+func (r *CustomHostnamesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomHostnamesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ops := planHostnameOperations(data.Hostnames, nil)
+	results, errs := r.apply(ctx, data, nil, ops)
+
+	// An error would taint the resource and replace every hostname on the
+	// next apply, so failures are only warnings unless nothing was created.
+	if len(ops.Create) > 0 && len(errs) == len(ops.Create) {
+		for _, hostname := range ops.Create {
+			resp.Diagnostics.AddAttributeError(path.Root("hostnames").AtMapKey(hostname), fmt.Sprintf("failed to create custom hostname %q", hostname), errs[hostname].Error())
+		}
+		return
+	}
+	for _, hostname := range ops.Create {
+		if err, ok := errs[hostname]; ok {
+			resp.Diagnostics.AddAttributeWarning(path.Root("hostnames").AtMapKey(hostname), fmt.Sprintf("failed to create custom hostname %q", hostname), fmt.Sprintf("%s\n\nThe hostname will be created on the next apply.", err))
+		}
+	}
+
+	if err := r.waitForActive(ctx, data, results); err != nil {
+		resp.Diagnostics.AddWarning("custom hostnames are not active", err.Error())
+	}
+
+	data.ID = data.ZoneID
+	resp.Diagnostics.Append(setHostnamesState(ctx, data, nil, results, errs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomHostnamesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomHostnamesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	current, err := listCustomHostnames(ctx, r.client, zoneID)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("zone %s no longer exists", zoneID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read custom hostnames", err.Error())
+		return
+	}
+
+	// The hostnames are only unset after an import, in which case every
+	// custom hostname of the zone is adopted.
+	if data.Hostnames == nil {
+		data.Hostnames = make(map[string]*CustomHostnameModel, len(current))
+		for hostname, ch := range current {
+			m, diags := customHostnameFromAPI(ctx, ch)
+			resp.Diagnostics.Append(diags...)
+			data.Hostnames[hostname] = m
+		}
+	}
+
+	for hostname, m := range data.Hostnames {
+		// Hostnames which failed to be created are kept until the next apply.
+		if m.ID.ValueString() == "" {
+			continue
+		}
+
+		ch, ok := current[hostname]
+		if !ok || ch.ID != m.ID.ValueString() {
+			tflog.Warn(ctx, fmt.Sprintf("custom hostname %s no longer exists in zone %s", hostname, zoneID))
+			delete(data.Hostnames, hostname)
+			continue
+		}
+
+		resp.Diagnostics.Append(refreshCustomHostname(ctx, m, ch)...)
+	}
+
+	data.ID = data.ZoneID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomHostnamesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *CustomHostnamesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ops := planHostnameOperations(data.Hostnames, state.Hostnames)
+	results, errs := r.apply(ctx, data, state, ops)
+
+	for _, op := range []struct {
+		action    string
+		hostnames []string
+	}{{"delete", ops.Delete}, {"create", ops.Create}, {"update", ops.Update}} {
+		for _, hostname := range op.hostnames {
+			if err, ok := errs[hostname]; ok {
+				resp.Diagnostics.AddAttributeError(path.Root("hostnames").AtMapKey(hostname), fmt.Sprintf("failed to %s custom hostname %q", op.action, hostname), err.Error())
+			}
+		}
+	}
+
+	if err := r.waitForActive(ctx, data, results); err != nil {
+		resp.Diagnostics.AddError("custom hostnames are not active", err.Error())
+	}
+
+	resp.Diagnostics.Append(setHostnamesState(ctx, data, state, results, errs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomHostnamesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomHostnamesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ops := planHostnameOperations(nil, data.Hostnames)
+	_, errs := r.apply(ctx, data, data, ops)
+
+	for _, hostname := range ops.Delete {
+		if err, ok := errs[hostname]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("hostnames").AtMapKey(hostname), fmt.Sprintf("failed to delete custom hostname %q", hostname), err.Error())
+		}
+	}
+}
+
+func (r *CustomHostnamesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.ZoneIDSchemaKey), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.IDSchemaKey), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("batch_size"), 10)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_active"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), "30m")...)
+}
+
+// apply runs the operations in batches of `batch_size`, deletions first so
+// they free up the zone's custom hostname quota. It returns the created and
+// updated hostnames and the errors keyed by hostname.
+func (r *CustomHostnamesResource) apply(ctx context.Context, data, state *CustomHostnamesModel, ops hostnameOperations) (map[string]cloudflare.CustomHostname, map[string]error) {
+	zoneID := data.ZoneID.ValueString()
+	batchSize := int(data.BatchSize.ValueInt64())
+
+	var mu sync.Mutex
+	results := make(map[string]cloudflare.CustomHostname)
+	errs := make(map[string]error)
+
+	record := func(hostname string, ch cloudflare.CustomHostname) {
+		mu.Lock()
+		results[hostname] = ch
+		mu.Unlock()
+	}
+
+	for hostname, err := range utils.ApplyInBatches(ctx, ops.Delete, batchSize, func(ctx context.Context, hostname string) error {
+		tflog.Debug(ctx, fmt.Sprintf("deleting custom hostname %s", hostname))
+
+		err := r.client.DeleteCustomHostname(ctx, zoneID, state.Hostnames[hostname].ID.ValueString())
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+		return err
+	}) {
+		errs[hostname] = err
+	}
+
+	for hostname, err := range utils.ApplyInBatches(ctx, ops.Create, batchSize, func(ctx context.Context, hostname string) error {
+		tflog.Debug(ctx, fmt.Sprintf("creating custom hostname %s", hostname))
+
+		ch, diags := buildCustomHostname(ctx, hostname, data.Hostnames[hostname])
+		if diags.HasError() {
+			return fmt.Errorf("invalid custom hostname configuration: %v", diags)
+		}
+
+		res, err := r.client.CreateCustomHostname(ctx, zoneID, ch)
+		if err != nil {
+			return err
+		}
+		record(hostname, res.Result)
+		return nil
+	}) {
+		errs[hostname] = err
+	}
+
+	for hostname, err := range utils.ApplyInBatches(ctx, ops.Update, batchSize, func(ctx context.Context, hostname string) error {
+		tflog.Debug(ctx, fmt.Sprintf("updating custom hostname %s", hostname))
+
+		ch, diags := buildCustomHostname(ctx, hostname, data.Hostnames[hostname])
+		if diags.HasError() {
+			return fmt.Errorf("invalid custom hostname configuration: %v", diags)
+		}
+
+		res, err := r.client.UpdateCustomHostname(ctx, zoneID, state.Hostnames[hostname].ID.ValueString(), ch)
+		if err != nil {
+			return err
+		}
+		record(hostname, res.Result)
+		return nil
+	}) {
+		errs[hostname] = err
+	}
+
+	return results, errs
+}
+
+// waitForActive waits for the created and updated hostnames to become active
+// when `wait_for_active` is set, updating results with their latest version.
+func (r *CustomHostnamesResource) waitForActive(ctx context.Context, data *CustomHostnamesModel, results map[string]cloudflare.CustomHostname) error {
+	if !data.WaitForActive.ValueBool() || len(results) == 0 {
+		return nil
+	}
+
+	timeout, _ := time.ParseDuration(data.Timeout.ValueString())
+	latest, err := waitForCustomHostnamesActive(ctx, r.client, data.ZoneID.ValueString(), results, int(data.BatchSize.ValueInt64()), timeout)
+	for hostname, ch := range latest {
+		results[hostname] = ch
+	}
+
+	return err
+}
+
+// setHostnamesState merges the results of the operations into the planned
+// hostnames. Hostnames which failed to be created are kept without an ID so
+// they are created on the next apply, while failed updates and deletions
+// keep their prior state.
+func setHostnamesState(ctx context.Context, data, state *CustomHostnamesModel, results map[string]cloudflare.CustomHostname, errs map[string]error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for hostname, m := range data.Hostnames {
+		var prior *CustomHostnameModel
+		if state != nil {
+			prior = state.Hostnames[hostname]
+		}
+
+		if ch, ok := results[hostname]; ok {
+			diags.Append(setCustomHostnameComputed(ctx, m, ch)...)
+			continue
+		}
+
+		if _, ok := errs[hostname]; ok {
+			if prior != nil && prior.ID.ValueString() != "" {
+				data.Hostnames[hostname] = prior
+			} else {
+				diags.Append(setCustomHostnameComputed(ctx, m, cloudflare.CustomHostname{})...)
+			}
+		}
+	}
+
+	for hostname := range errs {
+		if _, ok := data.Hostnames[hostname]; !ok && state != nil && state.Hostnames[hostname] != nil {
+			data.Hostnames[hostname] = state.Hostnames[hostname]
+		}
+	}
+
+	return diags
+}
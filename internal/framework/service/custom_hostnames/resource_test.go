@@ -0,0 +1,90 @@
+package custom_hostnames_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareCustomHostnames_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_custom_hostnames." + rnd
+	first := fmt.Sprintf("%s-1.%s", rnd, domain)
+	second := fmt.Sprintf("%s-2.%s", rnd, domain)
+	third := fmt.Sprintf("%s-3.%s", rnd, domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareCustomHostnamesConfig(rnd, zoneID, first, second),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "batch_size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "hostnames.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("hostnames.%s.ssl.method", first), "txt"),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("hostnames.%s.id", first)),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("hostnames.%s.ownership_verification_name", first)),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("hostnames.%s.id", second)),
+				),
+			},
+			{
+				Config: testAccCloudflareCustomHostnamesConfig(rnd, zoneID, second, third),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hostnames.%", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, fmt.Sprintf("hostnames.%s.id", first)),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("hostnames.%s.id", second)),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("hostnames.%s.id", third)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     zoneID,
+				ImportStateVerify: true,
+				// The import adopts every custom hostname of the zone.
+				ImportStateVerifyIgnore: []string{"hostnames"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareCustomHostnamesConfig(rnd, zoneID string, hostnames ...string) string {
+	entries := ""
+	for _, hostname := range hostnames {
+		entries += fmt.Sprintf(`
+    %q = {
+      ssl = {
+        method = "txt"
+        type   = "dv"
+        settings = {
+          min_tls_version = "1.2"
+        }
+      }
+    }`, hostname)
+	}
+
+	return fmt.Sprintf(`
+resource "cloudflare_custom_hostnames" "%[1]s" {
+  zone_id    = "%[2]s"
+  batch_size = 2
+
+  hostnames = {%[3]s
+  }
+}`, rnd, zoneID, entries)
+}
@@ -0,0 +1,204 @@
+package custom_hostnames
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var validationRecordAttrTypes = map[string]attr.Type{
+	"cname":        types.StringType,
+	"cname_target": types.StringType,
+	"txt_name":     types.StringType,
+	"txt_value":    types.StringType,
+	"http_url":     types.StringType,
+	"http_body":    types.StringType,
+}
+
+func onOffAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("%s %s", description, utils.RenderAvailableDocumentationValuesStringSlice([]string{"on", "off"})),
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("on", "off"),
+		},
+	}
+}
+
+func (r *CustomHostnamesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare resource to manage custom hostnames (also
+			known as SSL for SaaS) of a zone in bulk. Changes are applied in
+			batches and failures are reported per hostname; hostnames which
+			fail to be created are kept in the state without an ` + "`id`" + `
+			and retried on the next apply.
+
+			Only the hostnames in ` + "`hostnames`" + ` are managed. Importing
+			the resource adopts every custom hostname of the zone.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				MarkdownDescription: "The number of hostnames to create, update, delete or poll concurrently. Defaults to `10`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"wait_for_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for created and updated hostnames, and their certificates, to reach status `active`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the hostnames to become active, as a duration such as `30m` or `2h`. Defaults to `30m`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("30m"),
+			},
+			"hostnames": schema.MapNestedAttribute{
+				MarkdownDescription: "The custom hostnames, keyed by hostname.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"custom_origin_server": schema.StringAttribute{
+							MarkdownDescription: "The custom origin server used for the hostname.",
+							Optional:            true,
+						},
+						"custom_origin_sni": schema.StringAttribute{
+							MarkdownDescription: "The [custom origin SNI](https://developers.cloudflare.com/ssl/ssl-for-saas/hostname-specific-behavior/custom-origin) used for the hostname.",
+							Optional:            true,
+						},
+						"custom_metadata": schema.MapAttribute{
+							MarkdownDescription: "Custom metadata associated with the hostname.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+						"ssl": schema.SingleNestedAttribute{
+							MarkdownDescription: "SSL properties of the hostname's certificate.",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"method": schema.StringAttribute{
+									MarkdownDescription: fmt.Sprintf("Domain control validation (DCV) method used for the hostname. %s", utils.RenderAvailableDocumentationValuesStringSlice([]string{"http", "txt", "email"})),
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("http", "txt", "email"),
+									},
+								},
+								"type": schema.StringAttribute{
+									MarkdownDescription: fmt.Sprintf("Level of validation to be used for the hostname. %s", utils.RenderAvailableDocumentationValuesStringSlice([]string{"dv"})),
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("dv"),
+									},
+								},
+								"bundle_method": schema.StringAttribute{
+									MarkdownDescription: fmt.Sprintf("Method of building the certificate chain. %s", utils.RenderAvailableDocumentationValuesStringSlice([]string{"ubiquitous", "optimal", "force"})),
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("ubiquitous", "optimal", "force"),
+									},
+								},
+								"certificate_authority": schema.StringAttribute{
+									MarkdownDescription: fmt.Sprintf("The certificate authority issuing the certificate. %s", utils.RenderAvailableDocumentationValuesStringSlice([]string{"lets_encrypt", "digicert", "google"})),
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("lets_encrypt", "digicert", "google"),
+									},
+								},
+								"wildcard": schema.BoolAttribute{
+									MarkdownDescription: "Whether the certificate covers a wildcard.",
+									Optional:            true,
+								},
+								"settings": schema.SingleNestedAttribute{
+									MarkdownDescription: "SSL/TLS settings for the certificate.",
+									Optional:            true,
+									Attributes: map[string]schema.Attribute{
+										"http2":       onOffAttribute("Whether HTTP2 should be supported."),
+										"tls13":       onOffAttribute("Whether TLSv1.3 should be supported."),
+										"early_hints": onOffAttribute("Whether early hints should be supported."),
+										"min_tls_version": schema.StringAttribute{
+											MarkdownDescription: fmt.Sprintf("Lowest version of TLS the certificate should support. %s", utils.RenderAvailableDocumentationValuesStringSlice([]string{"1.0", "1.1", "1.2", "1.3"})),
+											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.OneOf("1.0", "1.1", "1.2", "1.3"),
+											},
+										},
+										"ciphers": schema.SetAttribute{
+											MarkdownDescription: "List of SSL/TLS ciphers to associate with the certificate.",
+											ElementType:         types.StringType,
+											Optional:            true,
+										},
+									},
+								},
+							},
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the custom hostname.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the hostname.",
+							Computed:            true,
+						},
+						"ssl_status": schema.StringAttribute{
+							MarkdownDescription: "The status of the hostname's certificate.",
+							Computed:            true,
+						},
+						"ownership_verification_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the TXT record proving ownership of the hostname.",
+							Computed:            true,
+						},
+						"ownership_verification_value": schema.StringAttribute{
+							MarkdownDescription: "The value of the TXT record proving ownership of the hostname.",
+							Computed:            true,
+						},
+						"validation_records": schema.ListAttribute{
+							MarkdownDescription: "The records to publish to validate the hostname's certificate.",
+							ElementType:         types.ObjectType{AttrTypes: validationRecordAttrTypes},
+							Computed:            true,
+						},
+						"verification_errors": schema.ListAttribute{
+							MarkdownDescription: "The errors preventing the hostname from becoming active.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
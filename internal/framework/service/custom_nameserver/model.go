@@ -0,0 +1,17 @@
+package custom_nameserver
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type CustomNameserverModel struct {
+	AccountID  types.String                   `tfsdk:"account_id"`
+	ID         types.String                   `tfsdk:"id"`
+	NSName     types.String                   `tfsdk:"ns_name"`
+	NSSet      types.Int64                    `tfsdk:"ns_set"`
+	Status     types.String                   `tfsdk:"status"`
+	DNSRecords []*CustomNameserverRecordModel `tfsdk:"dns_records"`
+}
+
+type CustomNameserverRecordModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
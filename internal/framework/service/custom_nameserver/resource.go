@@ -0,0 +1,169 @@
+package custom_nameserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomNameserverResource{}
+var _ resource.ResourceWithImportState = &CustomNameserverResource{}
+
+func NewResource() resource.Resource {
+	return &CustomNameserverResource{}
+}
+
+// CustomNameserverResource defines the resource implementation.
+type CustomNameserverResource struct {
+	client *cloudflare.API
+}
+
+func (r *CustomNameserverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_nameserver"
+}
+
+func (r *CustomNameserverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomNameserverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomNameserverModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ns, err := r.client.CreateCustomNameservers(ctx, cloudflare.AccountIdentifier(data.AccountID.ValueString()), cloudflare.CreateCustomNameserversParams{
+		NSName: strings.TrimSuffix(data.NSName.ValueString(), "."),
+		NSSet:  int(data.NSSet.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create custom nameserver", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildCustomNameserverModel(data, ns))...)
+}
+
+func (r *CustomNameserverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomNameserverModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameservers, err := r.client.GetCustomNameservers(ctx, cloudflare.AccountIdentifier(data.AccountID.ValueString()), cloudflare.GetCustomNameserversParams{})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read custom nameservers", err.Error())
+		return
+	}
+
+	for _, ns := range nameservers {
+		if strings.EqualFold(ns.NSName, data.ID.ValueString()) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, buildCustomNameserverModel(data, ns))...)
+			return
+		}
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("custom nameserver %s no longer exists", data.ID.ValueString()))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *CustomNameserverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CustomNameserverModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every configurable attribute requires replacement.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomNameserverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomNameserverModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCustomNameservers(ctx, cloudflare.AccountIdentifier(data.AccountID.ValueString()), cloudflare.DeleteCustomNameserversParams{
+		NSName: data.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete custom nameserver", err.Error())
+		return
+	}
+}
+
+func (r *CustomNameserverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idparts := strings.Split(req.ID, "/")
+	if len(idparts) != 2 {
+		resp.Diagnostics.AddError("error splitting import ID", "invalid ID specified. Please specify the ID as \"<account_id>/<ns_name>\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("account_id"), idparts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), idparts[1],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("ns_name"), idparts[1],
+	)...)
+}
+
+func buildCustomNameserverModel(data *CustomNameserverModel, ns cloudflare.CustomNameserverResult) *CustomNameserverModel {
+	records := make([]*CustomNameserverRecordModel, 0, len(ns.DNSRecords))
+	for _, record := range ns.DNSRecords {
+		records = append(records, &CustomNameserverRecordModel{
+			Type:  types.StringValue(record.Type),
+			Value: types.StringValue(record.Value),
+		})
+	}
+
+	// Keep the configured name when it only differs by the trailing dot.
+	name := types.StringValue(ns.NSName)
+	if strings.EqualFold(strings.TrimSuffix(data.NSName.ValueString(), "."), ns.NSName) {
+		name = data.NSName
+	}
+
+	return &CustomNameserverModel{
+		AccountID:  data.AccountID,
+		ID:         types.StringValue(ns.NSName),
+		NSName:     name,
+		NSSet:      types.Int64Value(int64(ns.NSSet)),
+		Status:     types.StringValue(ns.Status),
+		DNSRecords: records,
+	}
+}
@@ -0,0 +1,57 @@
+package custom_nameserver_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareCustomNameserver_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_custom_nameserver." + rnd
+	nsName := fmt.Sprintf("%s.%s", rnd, domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareCustomNameserverConfig(rnd, accountID, nsName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", nsName),
+					resource.TestCheckResourceAttr(resourceName, "ns_name", nsName),
+					resource.TestCheckResourceAttr(resourceName, "ns_set", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_records.0.value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", accountID, nsName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareCustomNameserverConfig(rnd, accountID, nsName string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_custom_nameserver" "%[1]s" {
+  account_id = "%[2]s"
+  ns_name    = "%[3]s"
+}`, rnd, accountID, nsName)
+}
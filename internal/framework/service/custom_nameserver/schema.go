@@ -0,0 +1,90 @@
+package custom_nameserver
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *CustomNameserverResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare account custom nameserver resource. Custom
+			nameservers are grouped into sets which can be assigned to zones
+			in the account with ` + "`cloudflare_zone_dns_settings`" + `.
+
+			The nameserver must be a hostname within a zone in the account
+			and the records in ` + "`dns_records`" + ` must be published at
+			the registrar of that zone as glue records.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.AccountIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ns_name": schema.StringAttribute{
+				MarkdownDescription: "The fully qualified hostname of the nameserver. **Modifying this attribute will force creation of a new resource.**",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ns_set": schema.Int64Attribute{
+				MarkdownDescription: "The set the nameserver belongs to. **Modifying this attribute will force creation of a new resource.**",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The verification status of the nameserver.",
+				Computed:            true,
+			},
+			"dns_records": schema.ListNestedAttribute{
+				MarkdownDescription: "The A and AAAA records the nameserver is reachable on.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The record type.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The IP address of the nameserver.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,10 @@
+package custom_ssl_ordering
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type CustomSSLOrderingModel struct {
+	ZoneID         types.String `tfsdk:"zone_id"`
+	ID             types.String `tfsdk:"id"`
+	CertificateIDs types.List   `tfsdk:"certificate_ids"`
+	Priorities     types.Map    `tfsdk:"priorities"`
+}
@@ -0,0 +1,43 @@
+package custom_ssl_ordering
+
+import (
+	"sort"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// certificatePriorities gives each certificate its position in the list as
+// priority, starting at 1 for the most preferred certificate.
+func certificatePriorities(certificateIDs []string) []cloudflare.ZoneCustomSSLPriority {
+	priorities := make([]cloudflare.ZoneCustomSSLPriority, 0, len(certificateIDs))
+	for i, id := range certificateIDs {
+		priorities = append(priorities, cloudflare.ZoneCustomSSLPriority{ID: id, Priority: i + 1})
+	}
+
+	return priorities
+}
+
+// orderedCertificateIDs returns the managed certificates that still exist in
+// the order they are currently served, keeping the configured order for
+// certificates with the same priority, along with their priorities.
+func orderedCertificateIDs(certificateIDs []string, certificates []cloudflare.ZoneCustomSSL) ([]string, map[string]int) {
+	priorities := make(map[string]int, len(certificates))
+	for _, certificate := range certificates {
+		priorities[certificate.ID] = certificate.Priority
+	}
+
+	ordered := make([]string, 0, len(certificateIDs))
+	managed := make(map[string]int, len(certificateIDs))
+	for _, id := range certificateIDs {
+		if priority, ok := priorities[id]; ok {
+			ordered = append(ordered, id)
+			managed[id] = priority
+		}
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return managed[ordered[i]] < managed[ordered[j]]
+	})
+
+	return ordered, managed
+}
@@ -0,0 +1,30 @@
+package custom_ssl_ordering
+
+import (
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificatePriorities(t *testing.T) {
+	assert.Equal(t, []cloudflare.ZoneCustomSSLPriority{
+		{ID: "b", Priority: 1},
+		{ID: "a", Priority: 2},
+		{ID: "c", Priority: 3},
+	}, certificatePriorities([]string{"b", "a", "c"}))
+}
+
+func TestOrderedCertificateIDs(t *testing.T) {
+	certificates := []cloudflare.ZoneCustomSSL{
+		{ID: "a", Priority: 2},
+		{ID: "b", Priority: 1},
+		{ID: "c", Priority: 2},
+		{ID: "unmanaged", Priority: 0},
+	}
+
+	ordered, priorities := orderedCertificateIDs([]string{"c", "a", "b", "deleted"}, certificates)
+
+	assert.Equal(t, []string{"b", "c", "a"}, ordered)
+	assert.Equal(t, map[string]int{"a": 2, "b": 1, "c": 2}, priorities)
+}
@@ -0,0 +1,186 @@
+package custom_ssl_ordering
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomSSLOrderingResource{}
+var _ resource.ResourceWithImportState = &CustomSSLOrderingResource{}
+
+func NewResource() resource.Resource {
+	return &CustomSSLOrderingResource{}
+}
+
+// CustomSSLOrderingResource defines the resource implementation.
+type CustomSSLOrderingResource struct {
+	client *cloudflare.API
+}
+
+func (r *CustomSSLOrderingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_ssl_ordering"
+}
+
+func (r *CustomSSLOrderingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomSSLOrderingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomSSLOrderingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.ZoneID
+	resp.Diagnostics.Append(r.reprioritize(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomSSLOrderingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomSSLOrderingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificates, err := r.client.ListSSL(ctx, data.ZoneID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("zone %s no longer exists", data.ZoneID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to list custom SSL certificates", err.Error())
+		return
+	}
+
+	// After an import every custom certificate of the zone is managed.
+	var certificateIDs []string
+	if data.CertificateIDs.IsNull() {
+		for _, certificate := range certificates {
+			certificateIDs = append(certificateIDs, certificate.ID)
+		}
+	} else {
+		resp.Diagnostics.Append(data.CertificateIDs.ElementsAs(ctx, &certificateIDs, false)...)
+	}
+
+	ordered, priorities := orderedCertificateIDs(certificateIDs, certificates)
+	if len(ordered) == 0 {
+		tflog.Warn(ctx, fmt.Sprintf("none of the ordered custom SSL certificates exist in zone %s anymore", data.ZoneID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = data.ZoneID
+	resp.Diagnostics.Append(setCertificateOrdering(ctx, data, ordered, priorities)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomSSLOrderingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CustomSSLOrderingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reprioritize(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomSSLOrderingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "removing custom SSL ordering from state, the certificate priorities are left unchanged")
+}
+
+func (r *CustomSSLOrderingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.ZoneIDSchemaKey), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.IDSchemaKey), req.ID)...)
+}
+
+// reprioritize sets the priorities of the certificates in the plan and
+// records the priorities returned by the API.
+func (r *CustomSSLOrderingResource) reprioritize(ctx context.Context, data *CustomSSLOrderingModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var certificateIDs []string
+	diags.Append(data.CertificateIDs.ElementsAs(ctx, &certificateIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	certificates, err := r.client.ReprioritizeSSL(ctx, data.ZoneID.ValueString(), certificatePriorities(certificateIDs))
+	if err != nil {
+		diags.AddError("failed to reprioritize custom SSL certificates", err.Error())
+		return diags
+	}
+
+	_, priorities := orderedCertificateIDs(certificateIDs, certificates)
+	for _, id := range certificateIDs {
+		if _, ok := priorities[id]; !ok {
+			diags.AddAttributeError(path.Root("certificate_ids"), "failed to reprioritize custom SSL certificates", fmt.Sprintf("certificate %q was not found in zone %s", id, data.ZoneID.ValueString()))
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(setCertificateOrdering(ctx, data, certificateIDs, priorities)...)
+
+	return diags
+}
+
+func setCertificateOrdering(ctx context.Context, data *CustomSSLOrderingModel, certificateIDs []string, priorities map[string]int) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.CertificateIDs, d = types.ListValueFrom(ctx, types.StringType, certificateIDs)
+	diags.Append(d...)
+
+	values := make(map[string]int64, len(priorities))
+	for id, priority := range priorities {
+		values[id] = int64(priority)
+	}
+	data.Priorities, d = types.MapValueFrom(ctx, types.Int64Type, values)
+	diags.Append(d...)
+
+	return diags
+}
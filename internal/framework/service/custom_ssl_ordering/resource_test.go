@@ -0,0 +1,90 @@
+package custom_ssl_ordering_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareCustomSSLOrdering_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_custom_ssl_ordering." + rnd
+
+	hostname := fmt.Sprintf("%s.%s", rnd, domain)
+	firstCert, firstKey, err := utils.GenerateEphemeralCertAndKey([]string{hostname}, time.Now().AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondCert, secondKey, err := utils.GenerateEphemeralCertAndKey([]string{hostname}, time.Now().AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareCustomSSLOrderingConfig(rnd, zoneID, firstCert, firstKey, secondCert, secondKey, "first", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "certificate_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_ids.0", "cloudflare_custom_ssl.first", "id"),
+					resource.TestCheckResourceAttr(resourceName, "priorities.%", "2"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareCustomSSLOrderingConfig(rnd, zoneID, firstCert, firstKey, secondCert, secondKey, "second", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_ids.0", "cloudflare_custom_ssl.second", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_ids.1", "cloudflare_custom_ssl.first", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareCustomSSLOrderingConfig(rnd, zoneID, firstCert, firstKey, secondCert, secondKey, preferred, fallback string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_custom_ssl" "first" {
+  zone_id = "%[2]s"
+  custom_ssl_options {
+    certificate   = %[3]q
+    private_key   = %[4]q
+    bundle_method = "force"
+    type          = "sni_custom"
+  }
+}
+
+resource "cloudflare_custom_ssl" "second" {
+  zone_id = "%[2]s"
+  custom_ssl_options {
+    certificate   = %[5]q
+    private_key   = %[6]q
+    bundle_method = "force"
+    type          = "sni_custom"
+  }
+}
+
+resource "cloudflare_custom_ssl_ordering" "%[1]s" {
+  zone_id = "%[2]s"
+  certificate_ids = [
+    cloudflare_custom_ssl.%[7]s.id,
+    cloudflare_custom_ssl.%[8]s.id,
+  ]
+}`, rnd, zoneID, firstCert, firstKey, secondCert, secondKey, preferred, fallback)
+}
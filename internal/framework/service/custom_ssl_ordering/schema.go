@@ -0,0 +1,65 @@
+package custom_ssl_ordering
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *CustomSSLOrderingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare resource to set the order in which the
+			custom SSL certificates of a zone are served when several of
+			them cover the same hostname. Certificate packs issued by
+			Cloudflare are always served after custom certificates and
+			can't be reordered.
+
+			Certificates which aren't listed keep their priority and the
+			priorities are left unchanged when the resource is destroyed.
+
+			~> Do not set ` + "`custom_ssl_priority`" + ` on ` + "`cloudflare_custom_ssl`" + `
+			   resources of a zone managed by this resource, the two would
+			   keep overwriting each other's priorities.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_ids": schema.ListAttribute{
+				MarkdownDescription: "The custom SSL certificate IDs, most preferred first. The certificates are given the priorities `1`, `2`, and so on in this order.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"priorities": schema.MapAttribute{
+				MarkdownDescription: "The priority of each certificate, keyed by certificate ID.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+		},
+	}
+}
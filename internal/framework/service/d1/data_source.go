@@ -0,0 +1,94 @@
+package d1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DatabasesDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &DatabasesDataSource{}
+}
+
+// DatabasesDataSource defines the data source implementation.
+type DatabasesDataSource struct {
+	client *cloudflare.API
+}
+
+func (r *DatabasesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_d1_databases"
+}
+
+func (r *DatabasesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatabasesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter *regexp.Regexp
+	if data.Filter != nil && data.Filter.Name.ValueString() != "" {
+		var err error
+		filter, err = regexp.Compile(data.Filter.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter").AtName("name"), "invalid name filter", err.Error())
+			return
+		}
+	}
+
+	databases, _, err := r.client.ListD1Databases(ctx, cloudflare.AccountIdentifier(data.AccountID.ValueString()), cloudflare.ListD1DatabasesParams{})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list D1 databases", err.Error())
+		return
+	}
+
+	data.ID = data.AccountID
+	data.Databases = make([]*DatabasesDatabaseModel, 0, len(databases))
+	for _, database := range databases {
+		if filter != nil && !filter.MatchString(database.Name) {
+			continue
+		}
+
+		model := &DatabasesDatabaseModel{
+			ID:        types.StringValue(database.UUID),
+			Name:      types.StringValue(database.Name),
+			Version:   types.StringValue(database.Version),
+			CreatedAt: types.StringNull(),
+		}
+		if database.CreatedAt != nil {
+			model.CreatedAt = types.StringValue(database.CreatedAt.Format(time.RFC3339))
+		}
+
+		data.Databases = append(data.Databases, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+package d1_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudflareD1DatabasesDataSource_Filter(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := "data.cloudflare_d1_databases." + rnd
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareD1DatabasesDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "databases.#", "1"),
+					resource.TestCheckResourceAttr(name, "databases.0.name", rnd),
+					resource.TestCheckResourceAttrSet(name, "databases.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareD1DatabasesDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_d1_database" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+
+data "cloudflare_d1_databases" "%[1]s" {
+  account_id = "%[2]s"
+
+  filter {
+    name = "^${cloudflare_d1_database.%[1]s.name}$"
+  }
+}`, rnd, accountID)
+}
@@ -0,0 +1,93 @@
+package d1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const defaultMigrationsTable = "d1_migrations"
+
+var migrationsTableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// migrationChecksum returns the checksum recorded for an applied migration.
+func migrationChecksum(sql string) string {
+	sum := sha256.Sum256([]byte(sql))
+	return hex.EncodeToString(sum[:])
+}
+
+// createMigrationsTableSQL returns the statement creating the table used to
+// track applied migrations. The table layout matches Wrangler so that both
+// tools can be used against the same database.
+func createMigrationsTableSQL(table string) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    name       TEXT UNIQUE,
+    applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);`, table)
+}
+
+// applyMigrationSQL returns the query that runs a migration and then records
+// it as applied, sent to D1 as a single request.
+func applyMigrationSQL(table, name, sql string) string {
+	sql = strings.TrimSpace(sql)
+	if !strings.HasSuffix(sql, ";") {
+		sql += ";"
+	}
+
+	return fmt.Sprintf("%s\nINSERT INTO %s (name) VALUES ('%s');", sql, table, strings.ReplaceAll(name, "'", "''"))
+}
+
+// changedMigrations returns the names of applied migrations whose content no
+// longer matches the checksum recorded when they were applied. Migrations
+// applied outside of Terraform have no recorded checksum and are skipped.
+func changedMigrations(applied map[string]string, migrations []*MigrationModel) []string {
+	var changed []string
+	for _, m := range migrations {
+		checksum, ok := applied[m.Name.ValueString()]
+		if !ok || checksum == "" {
+			continue
+		}
+
+		if checksum != migrationChecksum(m.SQL.ValueString()) {
+			changed = append(changed, m.Name.ValueString())
+		}
+	}
+
+	return changed
+}
+
+// pendingMigrations returns the migrations that have not been applied yet in
+// the order they are defined.
+func pendingMigrations(applied map[string]string, migrations []*MigrationModel) []*MigrationModel {
+	var pending []*MigrationModel
+	for _, m := range migrations {
+		if _, ok := applied[m.Name.ValueString()]; !ok {
+			pending = append(pending, m)
+		}
+	}
+
+	return pending
+}
+
+// duplicateMigrations returns the names that are used by more than one
+// migration.
+func duplicateMigrations(migrations []*MigrationModel) []string {
+	seen := make(map[string]bool, len(migrations))
+	var duplicates []string
+	for _, m := range migrations {
+		if m.Name.IsUnknown() {
+			continue
+		}
+
+		name := m.Name.ValueString()
+		if seen[name] {
+			duplicates = append(duplicates, name)
+		}
+		seen[name] = true
+	}
+
+	return duplicates
+}
@@ -0,0 +1,314 @@
+package d1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MigrationsResource{}
+var _ resource.ResourceWithImportState = &MigrationsResource{}
+var _ resource.ResourceWithModifyPlan = &MigrationsResource{}
+var _ resource.ResourceWithValidateConfig = &MigrationsResource{}
+
+func NewMigrationsResource() resource.Resource {
+	return &MigrationsResource{}
+}
+
+// MigrationsResource defines the resource implementation.
+type MigrationsResource struct {
+	client *cloudflare.API
+}
+
+func (r *MigrationsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_d1_migrations"
+}
+
+func (r *MigrationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MigrationsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var list types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("migration"), &list)...)
+
+	if resp.Diagnostics.HasError() || list.IsNull() || list.IsUnknown() {
+		return
+	}
+
+	var migrations []*MigrationModel
+	resp.Diagnostics.Append(list.ElementsAs(ctx, &migrations, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, name := range duplicateMigrations(migrations) {
+		resp.Diagnostics.AddAttributeError(path.Root("migration"), "duplicate D1 migration", fmt.Sprintf("migration %q is defined more than once", name))
+	}
+}
+
+func (r *MigrationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare against on create and nothing to plan on destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *MigrationsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, m := range plan.Migration {
+		if m.Name.IsUnknown() || m.SQL.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("applied_migrations"), types.MapUnknown(types.StringType))...)
+			return
+		}
+	}
+
+	applied := make(map[string]string)
+	resp.Diagnostics.Append(state.AppliedMigrations.ElementsAs(ctx, &applied, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, name := range changedMigrations(applied, plan.Migration) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("migration"),
+			"applied D1 migration has changed",
+			fmt.Sprintf("migration %q has already been applied but its contents no longer match the applied checksum. Applied migrations must not be modified, add a new migration instead.", name),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(pendingMigrations(applied, plan.Migration)) > 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("applied_migrations"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("applied_migrations"), state.AppliedMigrations)...)
+}
+
+func (r *MigrationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *MigrationsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.query(ctx, data, createMigrationsTableSQL(data.MigrationsTable.ValueString())); err != nil {
+		resp.Diagnostics.AddError("failed to create D1 migrations table", err.Error())
+		return
+	}
+
+	names, err := r.appliedMigrationNames(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read applied D1 migrations", err.Error())
+		return
+	}
+
+	applied := make(map[string]string, len(names))
+	for _, name := range names {
+		applied[name] = ""
+	}
+
+	data.ID = data.DatabaseID
+	resp.Diagnostics.Append(r.apply(ctx, data, applied)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MigrationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *MigrationsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, err := r.appliedMigrationNames(ctx, data)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("removing D1 migrations for database %q from state because the database is not present in the remote", data.DatabaseID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to read applied D1 migrations", err.Error())
+		return
+	}
+
+	previous := make(map[string]string)
+	if !data.AppliedMigrations.IsNull() {
+		resp.Diagnostics.Append(data.AppliedMigrations.ElementsAs(ctx, &previous, false)...)
+	}
+
+	applied := make(map[string]string, len(names))
+	for _, name := range names {
+		applied[name] = previous[name]
+	}
+
+	appliedMigrations, diags := types.MapValueFrom(ctx, types.StringType, applied)
+	resp.Diagnostics.Append(diags...)
+	data.AppliedMigrations = appliedMigrations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MigrationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *MigrationsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applied := make(map[string]string)
+	resp.Diagnostics.Append(state.AppliedMigrations.ElementsAs(ctx, &applied, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	resp.Diagnostics.Append(r.apply(ctx, data, applied)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MigrationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *MigrationsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("D1 migrations for database %q are not reverted and will only be removed from state", data.DatabaseID.ValueString()))
+}
+
+func (r *MigrationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idparts := strings.Split(req.ID, "/")
+	if len(idparts) != 2 {
+		resp.Diagnostics.AddError("error importing D1 migrations", `invalid ID specified. Please specify the ID as "<account_id>/<database_id>"`)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("account_id"), idparts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("database_id"), idparts[1],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), idparts[1],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("migrations_table"), defaultMigrationsTable,
+	)...)
+}
+
+// apply runs the pending migrations in order and records the applied
+// migrations on the model. Migrations applied before an error are kept so
+// that they are not run again.
+func (r *MigrationsResource) apply(ctx context.Context, data *MigrationsModel, applied map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, m := range pendingMigrations(applied, data.Migration) {
+		tflog.Info(ctx, fmt.Sprintf("applying D1 migration %q to database %q", m.Name.ValueString(), data.DatabaseID.ValueString()))
+
+		_, err := r.query(ctx, data, applyMigrationSQL(data.MigrationsTable.ValueString(), m.Name.ValueString(), m.SQL.ValueString()))
+		if err != nil {
+			diags.AddError(fmt.Sprintf("failed to apply D1 migration %q", m.Name.ValueString()), err.Error())
+			break
+		}
+
+		applied[m.Name.ValueString()] = migrationChecksum(m.SQL.ValueString())
+	}
+
+	appliedMigrations, d := types.MapValueFrom(ctx, types.StringType, applied)
+	diags.Append(d...)
+	data.AppliedMigrations = appliedMigrations
+
+	return diags
+}
+
+// appliedMigrationNames returns the names of the migrations recorded in the
+// migrations table in the order they were applied.
+func (r *MigrationsResource) appliedMigrationNames(ctx context.Context, data *MigrationsModel) ([]string, error) {
+	table := data.MigrationsTable.ValueString()
+
+	tables, err := r.query(ctx, data, "SELECT name FROM sqlite_master WHERE type = 'table' AND name = ?", table)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tables) == 0 || len(tables[0].Results) == 0 {
+		return []string{}, nil
+	}
+
+	results, err := r.query(ctx, data, fmt.Sprintf("SELECT name FROM %s ORDER BY id", table))
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, result := range results {
+		for _, row := range result.Results {
+			if name, ok := row["name"].(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+func (r *MigrationsResource) query(ctx context.Context, data *MigrationsModel, sql string, params ...string) ([]cloudflare.D1Result, error) {
+	if params == nil {
+		params = []string{}
+	}
+
+	return r.client.QueryD1Database(ctx, cloudflare.AccountIdentifier(data.AccountID.ValueString()), cloudflare.QueryD1DatabaseParams{
+		DatabaseID: data.DatabaseID.ValueString(),
+		SQL:        sql,
+		Parameters: params,
+	})
+}
@@ -0,0 +1,79 @@
+package d1_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudflareD1Migrations_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	resourceName := "cloudflare_d1_migrations." + rnd
+
+	initial := `{
+    name = "0001_create_users.sql"
+    sql  = "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT);"
+  }`
+	additional := `{
+    name = "0002_create_orders.sql"
+    sql  = "CREATE TABLE orders (id INTEGER PRIMARY KEY, user_id INTEGER);"
+  }`
+	modified := `{
+    name = "0001_create_users.sql"
+    sql  = "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT, email TEXT);"
+  }`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareD1MigrationsConfig(rnd, accountID, initial),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "migrations_table", "d1_migrations"),
+					resource.TestCheckResourceAttr(resourceName, "applied_migrations.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "applied_migrations.0001_create_users.sql"),
+				),
+			},
+			{
+				Config: testAccCloudflareD1MigrationsConfig(rnd, accountID, initial, additional),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "applied_migrations.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "applied_migrations.0002_create_orders.sql"),
+				),
+			},
+			{
+				Config:      testAccCloudflareD1MigrationsConfig(rnd, accountID, modified, additional),
+				ExpectError: regexp.MustCompile(`migration "0001_create_users.sql" has already been applied`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareD1MigrationsConfig(rnd, accountID string, migrations ...string) string {
+	config := fmt.Sprintf(`
+resource "cloudflare_d1_database" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+
+resource "cloudflare_d1_migrations" "%[1]s" {
+  account_id  = "%[2]s"
+  database_id = cloudflare_d1_database.%[1]s.id
+`, rnd, accountID)
+
+	for _, m := range migrations {
+		config += fmt.Sprintf("\n  migration %s\n", m)
+	}
+
+	return config + "}\n"
+}
@@ -0,0 +1,111 @@
+package d1
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *MigrationsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Docf(`
+			Provides a resource to apply SQL migrations to a %s.
+
+			Migrations are applied in the order they are defined and tracked
+			in a migrations table using the same layout as Wrangler, allowing
+			both tools to be used against the same database. Once applied, a
+			migration must not be modified; changes to applied migrations
+			fail the plan and should be made in a new migration instead.
+
+			~> The checksums of applied migrations are only kept in the
+			   Terraform state. Changes are not detected for migrations
+			   applied by Wrangler, nor for any migration after an import or
+			   a loss of the state. Statements of a migration which fails part
+			   way may not be rolled back, so prefer statements which can be
+			   run again such as %s.
+
+			Destroying this resource only removes it from state. Applied
+			migrations and the migrations table are left in place.
+		`, "`cloudflare_d1_database`", "`CREATE TABLE IF NOT EXISTS`"),
+
+		Attributes: map[string]schema.Attribute{
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.AccountIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the D1 database to apply the migrations to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"migrations_table": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultMigrationsTable),
+				MarkdownDescription: "The table used to track applied migrations.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						migrationsTableNameRegex,
+						"must be a valid SQL identifier",
+					),
+				},
+			},
+			"applied_migrations": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The applied migrations and the SHA-256 checksum of their contents. Migrations applied outside of Terraform have an empty checksum.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"migration": schema.ListNestedBlock{
+				MarkdownDescription: "An SQL migration. Pending migrations are applied in the order they are defined.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The unique name of the migration, usually the migration file name.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"sql": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The SQL statements of the migration.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package d1
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func migrations(pairs ...string) []*MigrationModel {
+	var m []*MigrationModel
+	for i := 0; i < len(pairs); i += 2 {
+		m = append(m, &MigrationModel{Name: types.StringValue(pairs[i]), SQL: types.StringValue(pairs[i+1])})
+	}
+	return m
+}
+
+func migrationNames(m []*MigrationModel) []string {
+	names := []string{}
+	for _, v := range m {
+		names = append(names, v.Name.ValueString())
+	}
+	return names
+}
+
+func TestMigrationChecksum(t *testing.T) {
+	// echo -n "CREATE TABLE a (id INTEGER);" | sha256sum
+	want := "5d8d272f6897eee56ebb6fa0b4db68595635b838afea6319ce9ac2659b097df7"
+	if got := migrationChecksum("CREATE TABLE a (id INTEGER);"); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if migrationChecksum("CREATE TABLE a (id INTEGER);") == migrationChecksum("CREATE TABLE b (id INTEGER);") {
+		t.Error("different migrations produced the same checksum")
+	}
+}
+
+func TestApplyMigrationSQL(t *testing.T) {
+	tests := map[string]struct {
+		name string
+		sql  string
+		want string
+	}{
+		"terminated statement": {
+			name: "0001_init.sql",
+			sql:  "CREATE TABLE a (id INTEGER);\n",
+			want: "CREATE TABLE a (id INTEGER);\nINSERT INTO d1_migrations (name) VALUES ('0001_init.sql');",
+		},
+		"unterminated statement": {
+			name: "0001_init.sql",
+			sql:  "CREATE TABLE a (id INTEGER)",
+			want: "CREATE TABLE a (id INTEGER);\nINSERT INTO d1_migrations (name) VALUES ('0001_init.sql');",
+		},
+		"quoted name": {
+			name: "0002_o'neil.sql",
+			sql:  "SELECT 1;",
+			want: "SELECT 1;\nINSERT INTO d1_migrations (name) VALUES ('0002_o''neil.sql');",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := applyMigrationSQL(defaultMigrationsTable, tc.name, tc.sql); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestChangedMigrations(t *testing.T) {
+	applied := map[string]string{
+		"0001": migrationChecksum("CREATE TABLE a (id INTEGER);"),
+		"0002": migrationChecksum("CREATE TABLE b (id INTEGER);"),
+		"0003": "",
+	}
+
+	tests := map[string]struct {
+		migrations []*MigrationModel
+		want       []string
+	}{
+		"unchanged": {
+			migrations: migrations("0001", "CREATE TABLE a (id INTEGER);", "0002", "CREATE TABLE b (id INTEGER);"),
+		},
+		"changed": {
+			migrations: migrations("0001", "CREATE TABLE a (id INTEGER, name TEXT);", "0002", "CREATE TABLE b (id INTEGER);"),
+			want:       []string{"0001"},
+		},
+		"applied outside of terraform": {
+			migrations: migrations("0003", "CREATE TABLE c (id INTEGER);"),
+		},
+		"pending": {
+			migrations: migrations("0004", "CREATE TABLE d (id INTEGER);"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := changedMigrations(applied, tc.migrations); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPendingMigrations(t *testing.T) {
+	applied := map[string]string{"0001": "", "0003": ""}
+	m := migrations("0001", "a", "0002", "b", "0003", "c", "0004", "d")
+
+	want := []string{"0002", "0004"}
+	if got := migrationNames(pendingMigrations(applied, m)); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestDuplicateMigrations(t *testing.T) {
+	m := migrations("0001", "a", "0002", "b", "0001", "c")
+
+	want := []string{"0001"}
+	if got := duplicateMigrations(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
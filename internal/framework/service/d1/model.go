@@ -8,3 +8,35 @@ type DatabaseModel struct {
 	ID        types.String `tfsdk:"id"`
 	Version   types.String `tfsdk:"version"`
 }
+
+type DatabasesModel struct {
+	AccountID types.String              `tfsdk:"account_id"`
+	ID        types.String              `tfsdk:"id"`
+	Filter    *DatabasesFilterModel     `tfsdk:"filter"`
+	Databases []*DatabasesDatabaseModel `tfsdk:"databases"`
+}
+
+type DatabasesFilterModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type DatabasesDatabaseModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Version   types.String `tfsdk:"version"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+type MigrationsModel struct {
+	AccountID         types.String      `tfsdk:"account_id"`
+	DatabaseID        types.String      `tfsdk:"database_id"`
+	ID                types.String      `tfsdk:"id"`
+	MigrationsTable   types.String      `tfsdk:"migrations_table"`
+	Migration         []*MigrationModel `tfsdk:"migration"`
+	AppliedMigrations types.Map         `tfsdk:"applied_migrations"`
+}
+
+type MigrationModel struct {
+	Name types.String `tfsdk:"name"`
+	SQL  types.String `tfsdk:"sql"`
+}
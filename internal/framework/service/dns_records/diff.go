@@ -0,0 +1,317 @@
+package dns_records
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+)
+
+// hostnameContentTypes are the record types whose content is a hostname and
+// is compared case insensitively, ignoring the trailing dot.
+var hostnameContentTypes = []string{"CNAME", "MX", "NS", "PTR"}
+
+// dnsRecord is the normalised form of a record used to compare the
+// configuration, the previous state and the records in the zone.
+type dnsRecord struct {
+	ID       string
+	Name     string
+	Type     string
+	Content  string
+	Data     map[string]string
+	Priority *uint16
+	TTL      int
+	Proxied  bool
+	Comment  string
+	Tags     []string
+}
+
+// key identifies a record. Records with the same key are considered to be the
+// same record and are updated in place when their settings differ.
+func (r dnsRecord) key() string {
+	value := normaliseContent(r.Type, r.Content)
+	if len(r.Data) > 0 {
+		fields := make([]string, 0, len(r.Data))
+		for k, v := range r.Data {
+			fields = append(fields, k+"="+v)
+		}
+		sort.Strings(fields)
+		value = strings.Join(fields, ",")
+	}
+
+	return strings.Join([]string{r.Type, r.Name, value}, "|")
+}
+
+// nameKey groups records of the same type and name so that a removed and an
+// added record can be applied as a single update.
+func (r dnsRecord) nameKey() string {
+	return r.Type + "|" + r.Name
+}
+
+func (r dnsRecord) settingsEqual(o dnsRecord) bool {
+	if r.TTL != o.TTL || r.Proxied != o.Proxied || r.Comment != o.Comment {
+		return false
+	}
+
+	if r.Priority != nil && (o.Priority == nil || *r.Priority != *o.Priority) {
+		return false
+	}
+
+	return tagsEqual(r.Tags, o.Tags)
+}
+
+func (r dnsRecord) String() string {
+	if len(r.Data) > 0 {
+		return fmt.Sprintf("%s %s", r.Type, r.Name)
+	}
+
+	return fmt.Sprintf("%s %s %s", r.Type, r.Name, r.Content)
+}
+
+// apiData converts the string data fields into the types expected by the API.
+func (r dnsRecord) apiData() (map[string]interface{}, error) {
+	if len(r.Data) == 0 {
+		return nil, nil
+	}
+
+	data := make(map[string]interface{}, len(r.Data))
+	for k, v := range r.Data {
+		value, err := utils.ParseDNSRecordDataValue(r.Type, k, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data for %s: %w", r, err)
+		}
+		data[k] = value
+	}
+
+	return data, nil
+}
+
+// recordFromAPI normalises a record returned by the API.
+func recordFromAPI(record cloudflare.DNSRecord) dnsRecord {
+	r := dnsRecord{
+		ID:      record.ID,
+		Name:    normaliseName(record.Name, record.ZoneName),
+		Type:    strings.ToUpper(record.Type),
+		Content: record.Content,
+		TTL:     record.TTL,
+		Comment: record.Comment,
+		Tags:    sortedTags(record.Tags),
+	}
+
+	if record.Proxied != nil {
+		r.Proxied = *record.Proxied
+	}
+
+	if hasPriority(r.Type) {
+		r.Priority = record.Priority
+	}
+
+	if data, ok := record.Data.(map[string]interface{}); ok && len(data) > 0 {
+		r.Data = make(map[string]string, len(data))
+		for k, v := range data {
+			r.Data[k] = utils.FormatDNSRecordDataValue(v)
+		}
+	}
+
+	return r
+}
+
+// normaliseName returns the fully qualified, lower case form of a record name
+// which may be relative to the zone or "@" for the zone apex.
+func normaliseName(name, zoneName string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	switch {
+	case name == "@" || name == "" || name == zoneName:
+		return zoneName
+	case strings.HasSuffix(name, "."+zoneName):
+		return name
+	default:
+		return name + "." + zoneName
+	}
+}
+
+// relativeName returns the name of a record relative to the zone, using "@"
+// for the zone apex.
+func relativeName(name, zoneName string) string {
+	name = strings.TrimSuffix(name, ".")
+	zoneName = strings.TrimSuffix(zoneName, ".")
+
+	if strings.EqualFold(name, zoneName) {
+		return "@"
+	}
+
+	if len(name) > len(zoneName)+1 && strings.EqualFold(name[len(name)-len(zoneName)-1:], "."+zoneName) {
+		return name[:len(name)-len(zoneName)-1]
+	}
+
+	return name
+}
+
+func normaliseContent(recordType, content string) string {
+	switch {
+	case recordType == "A" || recordType == "AAAA":
+		if ip := net.ParseIP(content); ip != nil {
+			return ip.String()
+		}
+	case utils.Contains(hostnameContentTypes, recordType):
+		return strings.ToLower(strings.TrimSuffix(content, "."))
+	}
+
+	return content
+}
+
+func hasPriority(recordType string) bool {
+	return recordType == "MX" || recordType == "URI"
+}
+
+func sortedTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	sorted := make([]string, len(tags))
+	copy(sorted, tags)
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+// tagsEqual compares two sorted lists of tags.
+func tagsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// recordScope limits the records owned by the resource.
+type recordScope struct {
+	Types      []string
+	NamePrefix string
+}
+
+func (s recordScope) includes(r dnsRecord) bool {
+	if len(s.Types) > 0 && !utils.Contains(s.Types, r.Type) {
+		return false
+	}
+
+	return strings.HasPrefix(r.Name, strings.ToLower(s.NamePrefix))
+}
+
+func (s recordScope) filter(records []dnsRecord) []dnsRecord {
+	var scoped []dnsRecord
+	for _, r := range records {
+		if s.includes(r) {
+			scoped = append(scoped, r)
+		}
+	}
+
+	return scoped
+}
+
+type recordUpdate struct {
+	ID     string
+	Record dnsRecord
+}
+
+type recordChanges struct {
+	Create []dnsRecord
+	Update []recordUpdate
+	Delete []dnsRecord
+
+	// Unmanaged are the records in the zone that the changes overwrite or
+	// delete without them being part of the previous state.
+	Unmanaged []dnsRecord
+}
+
+// diffRecords computes the changes required to turn the actual records of a
+// zone into the desired ones. The base records are the ones previously known
+// to Terraform and are used to tell managed records apart from unmanaged ones.
+//
+// Records are matched by type, name and content so unchanged records are
+// never touched. Removed and added records sharing a type and name are paired
+// into updates to keep the number of API calls, and the window in which a
+// name doesn't resolve, to a minimum.
+func diffRecords(base, desired, actual []dnsRecord) recordChanges {
+	var changes recordChanges
+
+	known := make(map[string]bool, len(base))
+	for _, r := range base {
+		known[r.key()] = true
+	}
+
+	existing := make(map[string][]dnsRecord, len(actual))
+	for _, r := range actual {
+		existing[r.key()] = append(existing[r.key()], r)
+	}
+
+	var create []dnsRecord
+	for _, d := range desired {
+		k := d.key()
+		matches := existing[k]
+		if len(matches) == 0 {
+			create = append(create, d)
+			continue
+		}
+
+		current := matches[0]
+		existing[k] = matches[1:]
+
+		if !d.settingsEqual(current) {
+			changes.Update = append(changes.Update, recordUpdate{ID: current.ID, Record: d})
+			if !known[k] {
+				changes.Unmanaged = append(changes.Unmanaged, current)
+			}
+		}
+	}
+
+	var remove []dnsRecord
+	for _, r := range actual {
+		k := r.key()
+		for _, leftover := range existing[k] {
+			if leftover.ID == r.ID {
+				remove = append(remove, r)
+				if !known[k] {
+					changes.Unmanaged = append(changes.Unmanaged, r)
+				}
+			}
+		}
+	}
+
+	removable := make(map[string][]dnsRecord, len(remove))
+	for _, r := range remove {
+		removable[r.nameKey()] = append(removable[r.nameKey()], r)
+	}
+
+	for _, d := range create {
+		candidates := removable[d.nameKey()]
+		if len(candidates) == 0 {
+			changes.Create = append(changes.Create, d)
+			continue
+		}
+
+		changes.Update = append(changes.Update, recordUpdate{ID: candidates[0].ID, Record: d})
+		removable[d.nameKey()] = candidates[1:]
+	}
+
+	for _, r := range remove {
+		for _, leftover := range removable[r.nameKey()] {
+			if leftover.ID == r.ID {
+				changes.Delete = append(changes.Delete, r)
+			}
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,209 @@
+package dns_records
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormaliseName(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		expected string
+	}{
+		"apex shorthand":      {name: "@", expected: "example.com"},
+		"apex":                {name: "example.com", expected: "example.com"},
+		"relative":            {name: "www", expected: "www.example.com"},
+		"fully qualified":     {name: "www.example.com", expected: "www.example.com"},
+		"trailing dot":        {name: "www.example.com.", expected: "www.example.com"},
+		"mixed case":          {name: "WWW.Example.com", expected: "www.example.com"},
+		"zone name as prefix": {name: "example.com.internal", expected: "example.com.internal.example.com"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, normaliseName(test.name, "example.com"))
+		})
+	}
+}
+
+func TestRelativeName(t *testing.T) {
+	assert.Equal(t, "@", relativeName("example.com", "example.com"))
+	assert.Equal(t, "www", relativeName("www.example.com", "example.com"))
+	assert.Equal(t, "a.b", relativeName("a.b.example.com", "example.com"))
+	assert.Equal(t, "notexample.com", relativeName("notexample.com", "example.com"))
+}
+
+func TestNormaliseContent(t *testing.T) {
+	assert.Equal(t, "2001:db8::1", normaliseContent("AAAA", "2001:0db8:0000:0000:0000:0000:0000:0001"))
+	assert.Equal(t, "192.0.2.1", normaliseContent("A", "192.0.2.1"))
+	assert.Equal(t, "target.example.com", normaliseContent("CNAME", "Target.Example.com."))
+	assert.Equal(t, "v=spf1 -all", normaliseContent("TXT", "v=spf1 -all"))
+}
+
+func TestRecordKeyUsesData(t *testing.T) {
+	a := dnsRecord{Type: "CAA", Name: "example.com", Content: `0 issue "letsencrypt.org"`, Data: map[string]string{"flags": "0", "tag": "issue", "value": "letsencrypt.org"}}
+	b := dnsRecord{Type: "CAA", Name: "example.com", Data: map[string]string{"value": "letsencrypt.org", "tag": "issue", "flags": "0"}}
+
+	assert.Equal(t, a.key(), b.key())
+}
+
+func TestRecordFromAPI(t *testing.T) {
+	priority := uint16(10)
+	record := recordFromAPI(cloudflare.DNSRecord{
+		ID:       "1",
+		Type:     "SRV",
+		Name:     "_sip._tcp.example.com",
+		ZoneName: "example.com",
+		Priority: &priority,
+		TTL:      300,
+		Proxied:  cloudflare.BoolPtr(false),
+		Tags:     []string{"b:2", "a:1"},
+		Data: map[string]interface{}{
+			"priority": float64(10),
+			"weight":   float64(5),
+			"port":     float64(5060),
+			"target":   "sip.example.com",
+		},
+	})
+
+	assert.Equal(t, "_sip._tcp.example.com", record.Name)
+	assert.Nil(t, record.Priority, "priority is only kept for MX and URI records")
+	assert.Equal(t, []string{"a:1", "b:2"}, record.Tags)
+	assert.Equal(t, map[string]string{"priority": "10", "weight": "5", "port": "5060", "target": "sip.example.com"}, record.Data)
+}
+
+func TestRecordAPIData(t *testing.T) {
+	record := dnsRecord{Type: "LOC", Name: "example.com", Data: map[string]string{"lat_degrees": "37", "lat_seconds": "1.5", "lat_direction": "N"}}
+
+	data, err := record.apiData()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"lat_degrees": 37, "lat_seconds": 1.5, "lat_direction": "N"}, data)
+
+	record.Data["lat_degrees"] = "north"
+	_, err = record.apiData()
+	assert.Error(t, err)
+}
+
+func TestRecordScope(t *testing.T) {
+	scope := recordScope{Types: []string{"TXT"}, NamePrefix: "_acme"}
+
+	assert.True(t, scope.includes(dnsRecord{Type: "TXT", Name: "_acme-challenge.example.com"}))
+	assert.False(t, scope.includes(dnsRecord{Type: "A", Name: "_acme-challenge.example.com"}))
+	assert.False(t, scope.includes(dnsRecord{Type: "TXT", Name: "example.com"}))
+	assert.True(t, recordScope{}.includes(dnsRecord{Type: "A", Name: "example.com"}))
+}
+
+func TestDiffRecords(t *testing.T) {
+	a := func(id, name, content string) dnsRecord {
+		return dnsRecord{ID: id, Type: "A", Name: name, Content: content, TTL: 1}
+	}
+
+	tests := map[string]struct {
+		base, desired, actual []dnsRecord
+		expected              recordChanges
+	}{
+		"unchanged": {
+			base:     []dnsRecord{a("", "www.example.com", "192.0.2.1")},
+			desired:  []dnsRecord{a("", "www.example.com", "192.0.2.1")},
+			actual:   []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			expected: recordChanges{},
+		},
+		"identical unmanaged record is adopted": {
+			desired:  []dnsRecord{a("", "www.example.com", "192.0.2.1")},
+			actual:   []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			expected: recordChanges{},
+		},
+		"create": {
+			base:     []dnsRecord{},
+			desired:  []dnsRecord{a("", "www.example.com", "192.0.2.1")},
+			expected: recordChanges{Create: []dnsRecord{a("", "www.example.com", "192.0.2.1")}},
+		},
+		"settings changed": {
+			base:    []dnsRecord{a("", "www.example.com", "192.0.2.1")},
+			desired: []dnsRecord{{Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 300}},
+			actual:  []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			expected: recordChanges{Update: []recordUpdate{
+				{ID: "1", Record: dnsRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 300}},
+			}},
+		},
+		"settings of unmanaged record changed": {
+			desired: []dnsRecord{{Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 300}},
+			actual:  []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			expected: recordChanges{
+				Update: []recordUpdate{
+					{ID: "1", Record: dnsRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 300}},
+				},
+				Unmanaged: []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			},
+		},
+		"managed record removed": {
+			base:     []dnsRecord{a("", "www.example.com", "192.0.2.1")},
+			desired:  []dnsRecord{},
+			actual:   []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			expected: recordChanges{Delete: []dnsRecord{a("1", "www.example.com", "192.0.2.1")}},
+		},
+		"unmanaged record removed": {
+			desired: []dnsRecord{},
+			actual:  []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			expected: recordChanges{
+				Delete:    []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+				Unmanaged: []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			},
+		},
+		"content change is applied as an update": {
+			base:    []dnsRecord{a("", "www.example.com", "192.0.2.1")},
+			desired: []dnsRecord{a("", "www.example.com", "192.0.2.2")},
+			actual:  []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			expected: recordChanges{Update: []recordUpdate{
+				{ID: "1", Record: a("", "www.example.com", "192.0.2.2")},
+			}},
+		},
+		"type change deletes before creating": {
+			base:    []dnsRecord{a("", "www.example.com", "192.0.2.1")},
+			desired: []dnsRecord{{Type: "CNAME", Name: "www.example.com", Content: "example.com", TTL: 1}},
+			actual:  []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			expected: recordChanges{
+				Create: []dnsRecord{{Type: "CNAME", Name: "www.example.com", Content: "example.com", TTL: 1}},
+				Delete: []dnsRecord{a("1", "www.example.com", "192.0.2.1")},
+			},
+		},
+		"record deleted outside of terraform is recreated": {
+			base:     []dnsRecord{a("", "www.example.com", "192.0.2.1")},
+			desired:  []dnsRecord{a("", "www.example.com", "192.0.2.1")},
+			actual:   []dnsRecord{},
+			expected: recordChanges{Create: []dnsRecord{a("", "www.example.com", "192.0.2.1")}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			changes := diffRecords(test.base, test.desired, test.actual)
+			assert.Equal(t, test.expected, changes)
+		})
+	}
+}
+
+func TestDiffRecordsLargeZone(t *testing.T) {
+	const size = 10000
+
+	var base, desired, actual []dnsRecord
+	for i := 0; i < size; i++ {
+		record := dnsRecord{Type: "A", Name: fmt.Sprintf("host-%d.example.com", i), Content: "192.0.2.1", TTL: 1}
+		base = append(base, record)
+		desired = append(desired, record)
+
+		record.ID = fmt.Sprint(i)
+		actual = append(actual, record)
+	}
+	desired[42].Content = "192.0.2.42"
+
+	changes := diffRecords(base, desired, actual)
+
+	assert.Empty(t, changes.Create)
+	assert.Empty(t, changes.Delete)
+	assert.Empty(t, changes.Unmanaged)
+	assert.Equal(t, []recordUpdate{{ID: "42", Record: desired[42]}}, changes.Update)
+}
@@ -0,0 +1,28 @@
+package dns_records
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type DNSRecordsModel struct {
+	ZoneID         types.String           `tfsdk:"zone_id"`
+	ID             types.String           `tfsdk:"id"`
+	AllowOverwrite types.Bool             `tfsdk:"allow_overwrite"`
+	Filter         *DNSRecordsFilterModel `tfsdk:"filter"`
+	Records        []*DNSRecordModel      `tfsdk:"records"`
+}
+
+type DNSRecordsFilterModel struct {
+	Types      types.Set    `tfsdk:"types"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+type DNSRecordModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Content  types.String `tfsdk:"content"`
+	Data     types.Map    `tfsdk:"data"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	Proxied  types.Bool   `tfsdk:"proxied"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Comment  types.String `tfsdk:"comment"`
+	Tags     types.Set    `tfsdk:"tags"`
+}
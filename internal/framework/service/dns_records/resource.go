@@ -0,0 +1,657 @@
+package dns_records
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// listRecordsPageSize is the largest page size supported when listing DNS
+// records, which keeps the number of requests low for large zones.
+const listRecordsPageSize = 5000
+
+// maxReportedRecords limits the number of records listed in diagnostics.
+const maxReportedRecords = 10
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DNSRecordsResource{}
+var _ resource.ResourceWithImportState = &DNSRecordsResource{}
+var _ resource.ResourceWithValidateConfig = &DNSRecordsResource{}
+
+func NewResource() resource.Resource {
+	return &DNSRecordsResource{}
+}
+
+// DNSRecordsResource defines the resource implementation.
+type DNSRecordsResource struct {
+	client *cloudflare.API
+}
+
+func (r *DNSRecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_records"
+}
+
+func (r *DNSRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSRecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var records types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("records"), &records)...)
+	if resp.Diagnostics.HasError() || records.IsNull() || records.IsUnknown() {
+		return
+	}
+
+	var filterTypes types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("filter").AtName("types"), &filterTypes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var allowedTypes []string
+	if !filterTypes.IsNull() && !filterTypes.IsUnknown() {
+		resp.Diagnostics.Append(filterTypes.ElementsAs(ctx, &allowedTypes, false)...)
+	}
+
+	var models []*DNSRecordModel
+	resp.Diagnostics.Append(records.ElementsAs(ctx, &models, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, m := range models {
+		if m.Type.IsUnknown() || m.Name.IsUnknown() {
+			continue
+		}
+		recordType := m.Type.ValueString()
+		attrPath := path.Root("records")
+		label := fmt.Sprintf("%s record %q", recordType, m.Name.ValueString())
+
+		if !m.Content.IsUnknown() && !m.Data.IsUnknown() && m.Content.IsNull() == m.Data.IsNull() {
+			resp.Diagnostics.AddAttributeError(attrPath, "invalid DNS record", fmt.Sprintf("%s must set exactly one of content or data", label))
+		}
+
+		if m.Proxied.ValueBool() && !m.TTL.IsNull() && !m.TTL.IsUnknown() && m.TTL.ValueInt64() != 1 {
+			resp.Diagnostics.AddAttributeError(attrPath, "invalid DNS record", fmt.Sprintf("%s must have a ttl of 1 when proxied is true", label))
+		}
+
+		if !m.Priority.IsNull() && !hasPriority(recordType) {
+			resp.Diagnostics.AddAttributeError(attrPath, "invalid DNS record", fmt.Sprintf("%s can't set priority, it is only used by MX and URI records", label))
+		}
+
+		if len(allowedTypes) > 0 && !utils.Contains(allowedTypes, recordType) {
+			resp.Diagnostics.AddAttributeError(attrPath, "invalid DNS record", fmt.Sprintf("%s is excluded by the filter and would never be managed", label))
+		}
+
+		if !m.Data.IsNull() && !m.Data.IsUnknown() {
+			data := make(map[string]types.String, len(m.Data.Elements()))
+			resp.Diagnostics.Append(m.Data.ElementsAs(ctx, &data, false)...)
+			for k, v := range data {
+				if v.IsUnknown() {
+					continue
+				}
+				if _, err := utils.ParseDNSRecordDataValue(recordType, k, v.ValueString()); err != nil {
+					resp.Diagnostics.AddAttributeError(attrPath, "invalid DNS record", fmt.Sprintf("%s has invalid data: %s", label, err))
+				}
+			}
+		}
+	}
+}
+
+func (r *DNSRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DNSRecordsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.ZoneID
+	resp.Diagnostics.Append(r.apply(ctx, data, nil)...)
+	if resp.Diagnostics.HasError() {
+		r.setActualState(ctx, data, &resp.State, &resp.Diagnostics)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DNSRecordsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName, err := r.zoneName(ctx, data.ZoneID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("zone %s no longer exists", data.ZoneID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read DNS records", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.readRecords(ctx, data, zoneName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *DNSRecordsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, state)...)
+	if resp.Diagnostics.HasError() {
+		r.setActualState(ctx, data, &resp.State, &resp.Diagnostics)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DNSRecordsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	zoneName, err := r.zoneName(ctx, zoneID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete DNS records", err.Error())
+		return
+	}
+
+	managed, diags := expandRecords(ctx, data.Records, zoneName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actual, err := r.listRecords(ctx, zoneID, expandScope(ctx, data.Filter))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete DNS records", err.Error())
+		return
+	}
+
+	// Only the records known to Terraform are deleted, anything created since
+	// the last refresh is left in place.
+	changes := diffRecords(managed, nil, actual)
+	changes.Delete = managedRecords(changes.Delete, managed)
+
+	if err := r.applyChanges(ctx, zoneID, changes); err != nil {
+		resp.Diagnostics.AddError("failed to delete DNS records", err.Error())
+		return
+	}
+}
+
+func (r *DNSRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// apply reconciles the records in the zone with the planned records. The
+// records in the previous state, if any, are the base of the three-way diff.
+func (r *DNSRecordsResource) apply(ctx context.Context, data, state *DNSRecordsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zoneID := data.ZoneID.ValueString()
+	zoneName, err := r.zoneName(ctx, zoneID)
+	if err != nil {
+		diags.AddError("failed to apply DNS records", err.Error())
+		return diags
+	}
+
+	scope := expandScope(ctx, data.Filter)
+
+	desired, d := expandRecords(ctx, data.Records, zoneName)
+	diags.Append(d...)
+
+	var base []dnsRecord
+	if state != nil {
+		base, d = expandRecords(ctx, state.Records, zoneName)
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	seen := make(map[string]bool, len(desired))
+	for _, record := range desired {
+		if !scope.includes(record) {
+			diags.AddAttributeError(path.Root("records"), "invalid DNS record", fmt.Sprintf("%s is excluded by the filter and would never be managed", record))
+		}
+		if seen[record.key()] {
+			diags.AddAttributeError(path.Root("records"), "duplicate DNS record", fmt.Sprintf("%s is configured more than once", record))
+		}
+		seen[record.key()] = true
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	actual, err := r.listRecords(ctx, zoneID, scope)
+	if err != nil {
+		diags.AddError("failed to apply DNS records", err.Error())
+		return diags
+	}
+
+	changes := diffRecords(base, desired, actual)
+	tflog.Debug(ctx, fmt.Sprintf("DNS records for zone %s: %d to create, %d to update, %d to delete", zoneID, len(changes.Create), len(changes.Update), len(changes.Delete)))
+
+	if len(changes.Unmanaged) > 0 && !data.AllowOverwrite.ValueBool() {
+		diags.AddError(
+			"DNS records not managed by Terraform",
+			fmt.Sprintf(
+				"%d existing records in zone %s would be overwritten or deleted: %s. Set allow_overwrite to true to let Terraform manage them, or change the filter to exclude them.",
+				len(changes.Unmanaged), zoneName, describeRecords(changes.Unmanaged),
+			),
+		)
+		return diags
+	}
+
+	if err := r.applyChanges(ctx, zoneID, changes); err != nil {
+		diags.AddError("failed to apply DNS records", err.Error())
+	}
+
+	return diags
+}
+
+func (r *DNSRecordsResource) applyChanges(ctx context.Context, zoneID string, changes recordChanges) error {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+
+	// Deletions go first so that records of a conflicting type, such as a
+	// CNAME replacing an A record, can be created afterwards.
+	for _, record := range changes.Delete {
+		tflog.Debug(ctx, fmt.Sprintf("deleting DNS record %s", record))
+		if err := r.client.DeleteDNSRecord(ctx, rc, record.ID); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", record, err)
+		}
+	}
+
+	for _, update := range changes.Update {
+		record := update.Record
+		recordData, err := record.apiData()
+		if err != nil {
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("updating DNS record %s", record))
+		_, err = r.client.UpdateDNSRecord(ctx, rc, cloudflare.UpdateDNSRecordParams{
+			ID:       update.ID,
+			Type:     record.Type,
+			Name:     record.Name,
+			Content:  recordContent(record),
+			Data:     recordData,
+			Priority: record.Priority,
+			TTL:      record.TTL,
+			Proxied:  cloudflare.BoolPtr(record.Proxied),
+			Comment:  cloudflare.StringPtr(record.Comment),
+			Tags:     recordTags(record),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update %s: %w", record, err)
+		}
+	}
+
+	for _, record := range changes.Create {
+		recordData, err := record.apiData()
+		if err != nil {
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("creating DNS record %s", record))
+		_, err = r.client.CreateDNSRecord(ctx, rc, cloudflare.CreateDNSRecordParams{
+			Type:     record.Type,
+			Name:     record.Name,
+			Content:  recordContent(record),
+			Data:     recordData,
+			Priority: record.Priority,
+			TTL:      record.TTL,
+			Proxied:  cloudflare.BoolPtr(record.Proxied),
+			Comment:  record.Comment,
+			Tags:     record.Tags,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", record, err)
+		}
+	}
+
+	return nil
+}
+
+// setActualState stores the records currently in the zone after a partially
+// applied change so that the next plan starts from what was actually done.
+func (r *DNSRecordsResource) setActualState(ctx context.Context, data *DNSRecordsModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	zoneName, err := r.zoneName(ctx, data.ZoneID.ValueString())
+	if err != nil {
+		return
+	}
+
+	readDiags := r.readRecords(ctx, data, zoneName)
+	if readDiags.HasError() {
+		return
+	}
+
+	diags.Append(state.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordsResource) readRecords(ctx context.Context, data *DNSRecordsModel, zoneName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	actual, err := r.listRecords(ctx, data.ZoneID.ValueString(), expandScope(ctx, data.Filter))
+	if err != nil {
+		diags.AddError("failed to read DNS records", err.Error())
+		return diags
+	}
+
+	data.Records = flattenRecords(ctx, data.Records, actual, zoneName)
+	if data.AllowOverwrite.IsNull() {
+		data.AllowOverwrite = types.BoolValue(false)
+	}
+
+	return diags
+}
+
+func (r *DNSRecordsResource) zoneName(ctx context.Context, zoneID string) (string, error) {
+	zone, err := r.client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return "", err
+	}
+
+	return zone.Name, nil
+}
+
+// listRecords returns the records of the zone within the scope. Locked
+// records can't be changed and are skipped.
+func (r *DNSRecordsResource) listRecords(ctx context.Context, zoneID string, scope recordScope) ([]dnsRecord, error) {
+	params := cloudflare.ListDNSRecordsParams{
+		ResultInfo: cloudflare.ResultInfo{Page: 1, PerPage: listRecordsPageSize},
+	}
+	if len(scope.Types) == 1 {
+		params.Type = scope.Types[0]
+	}
+
+	var records []dnsRecord
+	for {
+		page, resultInfo, err := r.client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list DNS records: %w", err)
+		}
+
+		for _, record := range page {
+			if record.Locked {
+				continue
+			}
+			records = append(records, recordFromAPI(record))
+		}
+
+		if resultInfo == nil || !resultInfo.HasMorePages() {
+			break
+		}
+		params.Page++
+	}
+
+	return scope.filter(records), nil
+}
+
+func expandScope(ctx context.Context, filter *DNSRecordsFilterModel) recordScope {
+	var scope recordScope
+	if filter == nil {
+		return scope
+	}
+
+	var recordTypes []string
+	filter.Types.ElementsAs(ctx, &recordTypes, false)
+	for _, t := range recordTypes {
+		scope.Types = append(scope.Types, strings.ToUpper(t))
+	}
+	scope.NamePrefix = filter.NamePrefix.ValueString()
+
+	return scope
+}
+
+func expandRecords(ctx context.Context, models []*DNSRecordModel, zoneName string) ([]dnsRecord, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	records := make([]dnsRecord, 0, len(models))
+	for _, m := range models {
+		record, d := expandRecord(ctx, m, zoneName)
+		diags.Append(d...)
+		records = append(records, record)
+	}
+
+	return records, diags
+}
+
+func expandRecord(ctx context.Context, m *DNSRecordModel, zoneName string) (dnsRecord, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	record := dnsRecord{
+		Name:    normaliseName(m.Name.ValueString(), zoneName),
+		Type:    strings.ToUpper(m.Type.ValueString()),
+		Content: m.Content.ValueString(),
+		TTL:     1,
+		Proxied: m.Proxied.ValueBool(),
+		Comment: m.Comment.ValueString(),
+	}
+
+	if !m.TTL.IsNull() {
+		record.TTL = int(m.TTL.ValueInt64())
+	}
+
+	if !m.Priority.IsNull() {
+		priority := uint16(m.Priority.ValueInt64())
+		record.Priority = &priority
+	}
+
+	if !m.Data.IsNull() {
+		diags.Append(m.Data.ElementsAs(ctx, &record.Data, false)...)
+	}
+
+	if !m.Tags.IsNull() {
+		var tags []string
+		diags.Append(m.Tags.ElementsAs(ctx, &tags, false)...)
+		record.Tags = sortedTags(tags)
+	}
+
+	return record, diags
+}
+
+// flattenRecords builds the records attribute from the records in the zone.
+// Records that match one in the prior state keep its representation, such as
+// a relative name or unset optional values, unless the setting has drifted.
+func flattenRecords(ctx context.Context, prior []*DNSRecordModel, actual []dnsRecord, zoneName string) []*DNSRecordModel {
+	type priorRecord struct {
+		model  *DNSRecordModel
+		record dnsRecord
+	}
+
+	known := make(map[string][]priorRecord, len(prior))
+	for _, m := range prior {
+		record, diags := expandRecord(ctx, m, zoneName)
+		if diags.HasError() {
+			continue
+		}
+		known[record.key()] = append(known[record.key()], priorRecord{model: m, record: record})
+	}
+
+	models := make([]*DNSRecordModel, 0, len(actual))
+	for _, a := range actual {
+		matches := known[a.key()]
+		if len(matches) == 0 {
+			models = append(models, flattenRecord(a, zoneName))
+			continue
+		}
+		known[a.key()] = matches[1:]
+
+		p := matches[0]
+		model := *p.model
+		if p.record.TTL != a.TTL {
+			model.TTL = types.Int64Value(int64(a.TTL))
+		}
+		if p.record.Proxied != a.Proxied {
+			model.Proxied = types.BoolValue(a.Proxied)
+		}
+		if p.record.Priority != nil && (a.Priority == nil || *p.record.Priority != *a.Priority) {
+			model.Priority = flattenPriority(a.Priority)
+		}
+		if p.record.Comment != a.Comment {
+			model.Comment = flattenOptionalString(a.Comment)
+		}
+		if !tagsEqual(p.record.Tags, a.Tags) {
+			model.Tags = flattenTags(a.Tags)
+		}
+
+		models = append(models, &model)
+	}
+
+	return models
+}
+
+func flattenRecord(record dnsRecord, zoneName string) *DNSRecordModel {
+	model := &DNSRecordModel{
+		Name:     types.StringValue(relativeName(record.Name, zoneName)),
+		Type:     types.StringValue(record.Type),
+		Content:  types.StringValue(record.Content),
+		Data:     types.MapNull(types.StringType),
+		TTL:      types.Int64Value(int64(record.TTL)),
+		Proxied:  types.BoolValue(record.Proxied),
+		Priority: flattenPriority(record.Priority),
+		Comment:  flattenOptionalString(record.Comment),
+		Tags:     flattenTags(record.Tags),
+	}
+
+	if len(record.Data) > 0 {
+		data := make(map[string]attr.Value, len(record.Data))
+		for k, v := range record.Data {
+			data[k] = types.StringValue(v)
+		}
+		model.Content = types.StringNull()
+		model.Data = types.MapValueMust(types.StringType, data)
+	}
+
+	return model
+}
+
+func flattenPriority(priority *uint16) types.Int64 {
+	if priority == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*priority))
+}
+
+func flattenOptionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(s)
+}
+
+func flattenTags(tags []string) types.Set {
+	if len(tags) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	values := make([]attr.Value, 0, len(tags))
+	for _, tag := range tags {
+		values = append(values, types.StringValue(tag))
+	}
+
+	return types.SetValueMust(types.StringType, values)
+}
+
+// managedRecords returns the records that match one of the managed records.
+func managedRecords(records, managed []dnsRecord) []dnsRecord {
+	keys := make(map[string]bool, len(managed))
+	for _, r := range managed {
+		keys[r.key()] = true
+	}
+
+	var filtered []dnsRecord
+	for _, r := range records {
+		if keys[r.key()] {
+			filtered = append(filtered, r)
+		}
+	}
+
+	return filtered
+}
+
+func recordContent(record dnsRecord) string {
+	if len(record.Data) > 0 {
+		return ""
+	}
+
+	return record.Content
+}
+
+func recordTags(record dnsRecord) []string {
+	if record.Tags == nil {
+		return []string{}
+	}
+
+	return record.Tags
+}
+
+func describeRecords(records []dnsRecord) string {
+	descriptions := make([]string, 0, maxReportedRecords)
+	for i, r := range records {
+		if i == maxReportedRecords {
+			descriptions = append(descriptions, fmt.Sprintf("and %d more", len(records)-maxReportedRecords))
+			break
+		}
+		descriptions = append(descriptions, r.String())
+	}
+
+	return strings.Join(descriptions, ", ")
+}
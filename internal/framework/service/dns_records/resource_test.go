@@ -0,0 +1,123 @@
+package dns_records_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareDNSRecords_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_dns_records." + rnd
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareDNSRecordsBasic(rnd, zoneID, domain, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name":    rnd + "-www",
+						"type":    "A",
+						"content": "192.0.2.1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name":       rnd + "-caa",
+						"type":       "CAA",
+						"data.tag":   "issue",
+						"data.value": "letsencrypt.org",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareDNSRecordsBasic(rnd, zoneID, domain, "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name":    rnd + "-www",
+						"type":    "A",
+						"content": "192.0.2.2",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareDNSRecordsSingle(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "records.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareDNSRecordsBasic(rnd, zoneID, domain, content string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_dns_records" "%[1]s" {
+    zone_id = "%[2]s"
+
+    filter {
+      name_prefix = "%[1]s-"
+    }
+
+    records = [
+      {
+        name    = "%[1]s-www"
+        type    = "A"
+        content = "%[4]s"
+        ttl     = 300
+        comment = "managed by terraform"
+      },
+      {
+        name    = "%[1]s-txt.%[3]s"
+        type    = "TXT"
+        content = "v=spf1 -all"
+      },
+      {
+        name = "%[1]s-caa"
+        type = "CAA"
+        data = {
+          flags = "0"
+          tag   = "issue"
+          value = "letsencrypt.org"
+        }
+      },
+    ]
+  }`, rnd, zoneID, domain, content)
+}
+
+func testAccCheckCloudflareDNSRecordsSingle(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_dns_records" "%[1]s" {
+    zone_id = "%[2]s"
+
+    filter {
+      name_prefix = "%[1]s-"
+    }
+
+    records = [
+      {
+        name    = "%[1]s-www"
+        type    = "A"
+        content = "192.0.2.2"
+        ttl     = 300
+        comment = "managed by terraform"
+      },
+    ]
+  }`, rnd, zoneID)
+}
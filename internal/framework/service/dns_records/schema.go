@@ -0,0 +1,141 @@
+package dns_records
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *DNSRecordsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare resource that authoritatively manages the DNS
+			records of a zone. Records in the zone that aren't part of the
+			configuration are removed, optionally limited to the records
+			matching a filter.
+
+			Changes are computed against the records in the zone so only
+			added, changed and removed records result in API calls.
+
+			!> Destroying this resource deletes every record it manages.
+			   Records created outside of Terraform are deleted on the next
+			   apply once they are in scope.
+
+			~> Do not manage the same records with ` + "`cloudflare_record`" + `
+			   and this resource.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_overwrite": schema.BoolAttribute{
+				MarkdownDescription: "Allow the resource to overwrite or delete records in scope that it hasn't managed before, such as existing records when the resource is created or records brought into scope by a filter change. Records identical to a configured record are always adopted.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"records": schema.SetNestedAttribute{
+				MarkdownDescription: "The DNS records of the zone.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the record. May be relative to the zone, fully qualified or `@` for the zone apex.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The type of the record. %s", utils.RenderAvailableDocumentationValuesStringSlice(utils.DNSRecordTypes)),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(utils.DNSRecordTypes...),
+							},
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The content of the record. Conflicts with `data`.",
+							Optional:            true,
+						},
+						"data": schema.MapAttribute{
+							MarkdownDescription: "The structured content of the record, as used by `cloudflare_record`, for types such as `SRV`, `CAA` or `LOC`. Numeric fields are given as strings. Conflicts with `content`.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+						"ttl": schema.Int64Attribute{
+							MarkdownDescription: "The TTL of the record. Defaults to `1`, which is automatic.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"proxied": schema.BoolAttribute{
+							MarkdownDescription: "Whether the record gets Cloudflare's origin protection. Defaults to `false`.",
+							Optional:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The priority of the record. Only used by `MX` and `URI` records.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Comments or notes about the record.",
+							Optional:            true,
+						},
+						"tags": schema.SetAttribute{
+							MarkdownDescription: "Custom tags for the record.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Limit the records managed by the resource. Records outside of the filter are left untouched.",
+				Attributes: map[string]schema.Attribute{
+					"types": schema.SetAttribute{
+						MarkdownDescription: "Only manage records of these types.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(utils.DNSRecordTypes...)),
+						},
+					},
+					"name_prefix": schema.StringAttribute{
+						MarkdownDescription: "Only manage records whose fully qualified name starts with this prefix.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package dns_zone_file
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DNSZoneFileDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &DNSZoneFileDataSource{}
+}
+
+type DNSZoneFileDataSource struct {
+	client *cloudflare.API
+}
+
+func (r *DNSZoneFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_file"
+}
+
+func (r *DNSZoneFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSZoneFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSZoneFileDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneFile, err := r.client.ExportDNSRecords(ctx, cloudflare.ZoneIdentifier(data.ZoneID.ValueString()), cloudflare.ExportDNSRecordsParams{})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to export DNS records", err.Error())
+		return
+	}
+
+	data.ID = data.ZoneID
+	data.ZoneFile = types.StringValue(zoneFile)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+package dns_zone_file
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (r *DNSZoneFileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Use this data source to export the DNS records of a zone as a
+			BIND zone file.
+		`),
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+			},
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+			},
+			"zone_file": schema.StringAttribute{
+				MarkdownDescription: "The DNS records of the zone in the BIND zone file format. Proxied records are marked with a `cf_tags=cf-proxied:true` comment.",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package dns_zone_file_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudflareDNSZoneFileDataSource_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	dataSourceName := "data.cloudflare_dns_zone_file." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareDNSZoneFileDataSourceBasic(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", zoneID),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexp.MustCompile(`(?m)^`+rnd+`\.\S+\s+\d+\s+IN\s+A\s+192\.0\.2\.1`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareDNSZoneFileDataSourceBasic(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[1]s" {
+  zone_id = "%[2]s"
+  name    = "%[1]s"
+  type    = "A"
+  value   = "192.0.2.1"
+}
+
+data "cloudflare_dns_zone_file" "%[1]s" {
+  zone_id = "%[2]s"
+
+  depends_on = [cloudflare_record.%[1]s]
+}`, rnd, zoneID)
+}
@@ -0,0 +1,18 @@
+package dns_zone_file
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type DNSZoneFileModel struct {
+	ZoneID         types.String `tfsdk:"zone_id"`
+	ID             types.String `tfsdk:"id"`
+	ZoneFile       types.String `tfsdk:"zone_file"`
+	SkipExisting   types.Bool   `tfsdk:"skip_existing"`
+	RecordsCreated types.Int64  `tfsdk:"records_created"`
+	RecordsSkipped types.Int64  `tfsdk:"records_skipped"`
+}
+
+type DNSZoneFileDataSourceModel struct {
+	ZoneID   types.String `tfsdk:"zone_id"`
+	ID       types.String `tfsdk:"id"`
+	ZoneFile types.String `tfsdk:"zone_file"`
+}
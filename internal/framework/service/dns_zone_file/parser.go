@@ -0,0 +1,427 @@
+package dns_zone_file
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+)
+
+// proxiedTag is the comment Cloudflare adds to exported records that are
+// proxied.
+const proxiedTag = "cf_tags=cf-proxied:true"
+
+// defaultTTL is used when neither the record nor a $TTL directive sets one.
+// A TTL of 1 means automatic.
+const defaultTTL = 1
+
+// zoneRecord is a record parsed from a zone file, ready to be sent to the API.
+type zoneRecord struct {
+	Name     string
+	Type     string
+	TTL      int
+	Content  string
+	Data     map[string]interface{}
+	Priority *uint16
+	Proxied  bool
+	Line     int
+}
+
+func (r zoneRecord) String() string {
+	return fmt.Sprintf("%s %s", r.Type, r.Name)
+}
+
+// hostnameTypes are the record types whose content is a domain name that is
+// relative to the origin unless it ends with a dot.
+var hostnameTypes = map[string]bool{
+	"CNAME": true,
+	"MX":    true,
+	"NS":    true,
+	"PTR":   true,
+}
+
+var recordClasses = map[string]bool{"IN": true, "CH": true, "HS": true, "CS": true}
+
+// parseZoneFile parses a BIND zone file into records. Relative names are
+// qualified with the origin, which may be overridden by $ORIGIN directives.
+//
+// SOA records and NS records at the zone apex are skipped as they're managed
+// by Cloudflare.
+func parseZoneFile(contents, origin string) ([]zoneRecord, error) {
+	p := &zoneParser{
+		origin: strings.TrimSuffix(strings.ToLower(origin), "."),
+		zone:   strings.TrimSuffix(strings.ToLower(origin), "."),
+		ttl:    defaultTTL,
+	}
+
+	entries, err := splitEntries(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []zoneRecord
+	for _, e := range entries {
+		record, ok, err := p.parseEntry(e)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", e.line, err)
+		}
+		if ok {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+// entry is a logical line of a zone file with parentheses joined and comments
+// removed.
+type entry struct {
+	line    int
+	tokens  []string
+	quoted  []bool
+	owner   bool
+	proxied bool
+}
+
+// splitEntries tokenises the zone file, joining lines enclosed in
+// parentheses and keeping quoted strings intact.
+func splitEntries(contents string) ([]entry, error) {
+	var entries []entry
+	var current *entry
+	depth := 0
+
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+
+		if depth == 0 {
+			if current != nil && len(current.tokens) > 0 {
+				entries = append(entries, *current)
+			}
+			current = &entry{
+				line:  lineNumber,
+				owner: len(line) > 0 && line[0] != ' ' && line[0] != '\t',
+			}
+		}
+
+		for i := 0; i < len(line); i++ {
+			c := line[i]
+			switch {
+			case c == ';':
+				if strings.Contains(line[i:], proxiedTag) {
+					current.proxied = true
+				}
+				i = len(line)
+			case c == '(':
+				depth++
+			case c == ')':
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNumber)
+				}
+				depth--
+			case c == ' ' || c == '\t':
+			case c == '"':
+				var sb strings.Builder
+				j := i + 1
+				for ; j < len(line) && line[j] != '"'; j++ {
+					if line[j] == '\\' && j+1 < len(line) {
+						j++
+					}
+					sb.WriteByte(line[j])
+				}
+				if j >= len(line) {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+				}
+				current.tokens = append(current.tokens, sb.String())
+				current.quoted = append(current.quoted, true)
+				i = j
+			default:
+				j := i
+				for ; j < len(line) && !strings.ContainsRune(" \t;()", rune(line[j])); j++ {
+					// Quotes inside a token, such as alpn="h2,h3", are kept.
+					if line[j] == '"' {
+						end := strings.IndexByte(line[j+1:], '"')
+						if end < 0 {
+							return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+						}
+						j += end + 1
+					}
+				}
+				current.tokens = append(current.tokens, line[i:j])
+				current.quoted = append(current.quoted, false)
+				i = j - 1
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.line)
+	}
+
+	if current != nil && len(current.tokens) > 0 {
+		entries = append(entries, *current)
+	}
+
+	return entries, nil
+}
+
+type zoneParser struct {
+	zone      string
+	origin    string
+	ttl       int
+	lastOwner string
+}
+
+func (p *zoneParser) parseEntry(e entry) (zoneRecord, bool, error) {
+	tokens := e.tokens
+
+	if strings.HasPrefix(tokens[0], "$") && !e.quoted[0] {
+		return zoneRecord{}, false, p.parseDirective(tokens)
+	}
+
+	record := zoneRecord{Line: e.line, Proxied: e.proxied, TTL: p.ttl}
+
+	if e.owner {
+		record.Name = p.qualify(tokens[0])
+		p.lastOwner = record.Name
+		tokens, e.quoted = tokens[1:], e.quoted[1:]
+	} else {
+		if p.lastOwner == "" {
+			return zoneRecord{}, false, fmt.Errorf("record has no owner name")
+		}
+		record.Name = p.lastOwner
+	}
+
+	// The TTL and class are optional and may appear in either order.
+	for i := 0; i < 2 && len(tokens) > 0; i++ {
+		token := strings.ToUpper(tokens[0])
+		if recordClasses[token] {
+			if token != "IN" {
+				return zoneRecord{}, false, fmt.Errorf("unsupported class %s", token)
+			}
+			tokens, e.quoted = tokens[1:], e.quoted[1:]
+			continue
+		}
+		if ttl, err := parseTTL(tokens[0]); err == nil {
+			record.TTL = ttl
+			tokens, e.quoted = tokens[1:], e.quoted[1:]
+		}
+	}
+
+	if len(tokens) == 0 {
+		return zoneRecord{}, false, fmt.Errorf("missing record type")
+	}
+
+	record.Type = strings.ToUpper(tokens[0])
+	rdata, quoted := tokens[1:], e.quoted[1:]
+
+	if record.Type == "SOA" || (record.Type == "NS" && record.Name == p.zone) {
+		return zoneRecord{}, false, nil
+	}
+
+	if len(rdata) == 0 {
+		return zoneRecord{}, false, fmt.Errorf("%s record %s has no data", record.Type, record.Name)
+	}
+
+	if err := p.parseRData(&record, rdata, quoted); err != nil {
+		return zoneRecord{}, false, fmt.Errorf("%s record %s: %w", record.Type, record.Name, err)
+	}
+
+	return record, true, nil
+}
+
+func (p *zoneParser) parseDirective(tokens []string) error {
+	switch strings.ToUpper(tokens[0]) {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$ORIGIN requires a single domain name")
+		}
+		p.origin = p.qualify(tokens[1])
+	case "$TTL":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$TTL requires a single value")
+		}
+		ttl, err := parseTTL(tokens[1])
+		if err != nil {
+			return err
+		}
+		p.ttl = ttl
+	case "$INCLUDE", "$GENERATE":
+		return fmt.Errorf("%s is not supported", tokens[0])
+	default:
+		return fmt.Errorf("unknown directive %s", tokens[0])
+	}
+
+	return nil
+}
+
+func (p *zoneParser) parseRData(record *zoneRecord, rdata []string, quoted []bool) error {
+	switch record.Type {
+	case "A", "AAAA":
+		if len(rdata) != 1 {
+			return fmt.Errorf("expected a single address")
+		}
+		record.Content = rdata[0]
+	case "CNAME", "NS", "PTR":
+		if len(rdata) != 1 {
+			return fmt.Errorf("expected a single domain name")
+		}
+		record.Content = p.qualify(rdata[0])
+	case "MX":
+		if len(rdata) != 2 {
+			return fmt.Errorf("expected a preference and an exchange")
+		}
+		priority, err := parsePriority(rdata[0])
+		if err != nil {
+			return err
+		}
+		record.Priority = &priority
+		record.Content = p.qualify(rdata[1])
+	case "TXT", "SPF":
+		record.Content = txtContent(rdata, quoted)
+	case "SRV":
+		// The service, protocol and name of the record are its owner name.
+		fields := []string{"priority", "weight", "port", "target"}
+		if len(rdata) != len(fields) {
+			return fmt.Errorf("expected %d fields (%s), got %d", len(fields), strings.Join(fields, ", "), len(rdata))
+		}
+
+		data := make(map[string]string, len(fields))
+		for i, field := range fields {
+			data[field] = rdata[i]
+		}
+		data["target"] = p.qualify(data["target"])
+
+		return p.setData(record, data)
+	default:
+		if _, ok := utils.DNSRecordDataFields[record.Type]; !ok {
+			return fmt.Errorf("unsupported record type")
+		}
+
+		// The URI priority is a field of the record rather than its data.
+		if record.Type == "URI" {
+			priority, err := parsePriority(rdata[0])
+			if err != nil {
+				return err
+			}
+			record.Priority = &priority
+			rdata = rdata[1:]
+		}
+
+		data, err := utils.DNSRecordContentToData(record.Type, rdata)
+		if err != nil {
+			return err
+		}
+
+		if target, ok := data["target"]; ok {
+			data["target"] = p.qualify(target)
+		}
+
+		return p.setData(record, data)
+	}
+
+	return nil
+}
+
+// setData converts the textual RDATA fields into the types the API expects.
+func (p *zoneParser) setData(record *zoneRecord, data map[string]string) error {
+	record.Data = make(map[string]interface{}, len(data))
+	for k, v := range data {
+		value, err := utils.ParseDNSRecordDataValue(record.Type, k, v)
+		if err != nil {
+			return err
+		}
+		record.Data[k] = value
+	}
+
+	return nil
+}
+
+// qualify returns the fully qualified form of a name, without the trailing
+// dot.
+func (p *zoneParser) qualify(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case name == ".":
+		return name
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(strings.TrimSuffix(name, "."))
+	case p.origin == "":
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + p.origin
+	}
+}
+
+func txtContent(rdata []string, quoted []bool) string {
+	if len(rdata) == 1 {
+		return rdata[0]
+	}
+
+	parts := make([]string, len(rdata))
+	for i, part := range rdata {
+		if quoted[i] {
+			part = strconv.Quote(part)
+		}
+		parts[i] = part
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// parseTTL parses a TTL in seconds or in the BIND unit notation such as
+// "1h30m".
+func parseTTL(s string) (int, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty TTL")
+	}
+
+	if ttl, err := strconv.Atoi(s); err == nil {
+		if ttl < 0 {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		return ttl, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, value, digits := 0, 0, 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			value = value*10 + int(c-'0')
+			digits++
+		case digits > 0 && units[c|0x20] > 0:
+			total += value * units[c|0x20]
+			value, digits = 0, 0
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+	}
+
+	if digits > 0 {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+
+	return total, nil
+}
+
+func parsePriority(s string) (uint16, error) {
+	priority, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid priority %q", s)
+	}
+
+	return uint16(priority), nil
+}
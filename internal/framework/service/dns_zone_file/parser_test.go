@@ -0,0 +1,149 @@
+package dns_zone_file
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func uint16Ptr(v uint16) *uint16 {
+	return &v
+}
+
+func TestParseZoneFileCloudflareExport(t *testing.T) {
+	contents, err := os.ReadFile("testdata/cloudflare_export.zone")
+	require.NoError(t, err)
+
+	records, err := parseZoneFile(string(contents), "example.com")
+	require.NoError(t, err)
+
+	for i := range records {
+		records[i].Line = 0
+	}
+
+	assert.Equal(t, []zoneRecord{
+		{Name: "example.com", Type: "A", TTL: 1, Content: "192.0.2.1", Proxied: true},
+		{Name: "api.example.com", Type: "A", TTL: 300, Content: "192.0.2.10"},
+		{Name: "example.com", Type: "AAAA", TTL: 1, Content: "2001:db8::1", Proxied: true},
+		{Name: "example.com", Type: "CAA", TTL: 1, Data: map[string]interface{}{"flags": "0", "tag": "issue", "value": "letsencrypt.org"}},
+		{Name: "www.example.com", Type: "CNAME", TTL: 1, Content: "example.com", Proxied: true},
+		{Name: "example.com", Type: "MX", TTL: 1, Content: "mx1.example.net", Priority: uint16Ptr(10)},
+		{Name: "example.com", Type: "MX", TTL: 1, Content: "mx2.example.net", Priority: uint16Ptr(20)},
+		{Name: "_sip._tcp.example.com", Type: "SRV", TTL: 1, Data: map[string]interface{}{"priority": 10, "weight": 5, "port": 5060, "target": "sip.example.com"}},
+		{Name: "example.com", Type: "TXT", TTL: 1, Content: "v=spf1 include:_spf.example.net -all"},
+		{Name: "_dmarc.example.com", Type: "TXT", TTL: 1, Content: "v=DMARC1; p=reject; rua=mailto:dmarc@example.com"},
+	}, records)
+}
+
+func TestParseZoneFileBIND(t *testing.T) {
+	contents, err := os.ReadFile("testdata/bind.zone")
+	require.NoError(t, err)
+
+	records, err := parseZoneFile(string(contents), "example.org")
+	require.NoError(t, err)
+
+	for i := range records {
+		records[i].Line = 0
+	}
+
+	assert.Equal(t, []zoneRecord{
+		{Name: "example.org", Type: "MX", TTL: 3600, Content: "mail.example.org", Priority: uint16Ptr(10)},
+		{Name: "example.org", Type: "A", TTL: 3600, Content: "198.51.100.1"},
+		{Name: "www.example.org", Type: "CNAME", TTL: 3600, Content: "example.org"},
+		{Name: "mail.example.org", Type: "A", TTL: 300, Content: "198.51.100.25"},
+		{Name: "mail.example.org", Type: "AAAA", TTL: 3600, Content: "2001:db8::25"},
+		{Name: "ftp.example.org", Type: "A", TTL: 300, Content: "198.51.100.30"},
+		{Name: "dev.example.org", Type: "NS", TTL: 3600, Content: "ns.dev.example.net"},
+		{Name: "long.example.org", Type: "TXT", TTL: 3600, Content: `"part one;" "part two"`},
+		{Name: "_http._tcp.example.org", Type: "SRV", TTL: 3600, Data: map[string]interface{}{"priority": 0, "weight": 1, "port": 80, "target": "www.example.org"}},
+		{Name: "ssh.example.org", Type: "SSHFP", TTL: 3600, Data: map[string]interface{}{"algorithm": 4, "type": 2, "fingerprint": "123456789abcdef67890123456789abcdef67890123456789abcdef123456789"}},
+		{Name: "_443._tcp.example.org", Type: "TLSA", TTL: 3600, Data: map[string]interface{}{"usage": 3, "selector": 1, "matching_type": 1, "certificate": "0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF"}},
+		{Name: "loc.example.org", Type: "LOC", TTL: 3600, Data: map[string]interface{}{
+			"lat_degrees": 52, "lat_minutes": 22, "lat_seconds": 23.0, "lat_direction": "N",
+			"long_degrees": 4, "long_minutes": 53, "long_seconds": 32.0, "long_direction": "E",
+			"altitude": -2.0, "size": 0.0, "precision_horz": 10000.0, "precision_vert": 10.0,
+		}},
+		{Name: "svc.example.org", Type: "HTTPS", TTL: 3600, Data: map[string]interface{}{"priority": 1, "target": ".", "value": `alpn="h2,h3"`}},
+		{Name: "_ftp._tcp.example.org", Type: "URI", TTL: 3600, Priority: uint16Ptr(10), Data: map[string]interface{}{"weight": 1, "content": "ftp://ftp.example.org/public"}},
+		{Name: "host.sub.example.org", Type: "A", TTL: 3600, Content: "198.51.100.40"},
+	}, records)
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	tests := map[string]struct {
+		contents string
+		err      string
+	}{
+		"unbalanced parentheses": {
+			contents: "www A ( 192.0.2.1\n",
+			err:      "unbalanced parentheses",
+		},
+		"unterminated string": {
+			contents: "www TXT \"oops\n",
+			err:      "unterminated quoted string",
+		},
+		"missing owner": {
+			contents: "  A 192.0.2.1\n",
+			err:      "line 1: record has no owner name",
+		},
+		"unsupported type": {
+			contents: "www HINFO \"PC\" \"Linux\"\n",
+			err:      "line 1: HINFO record www.example.com: unsupported record type",
+		},
+		"missing fields": {
+			contents: "_sip._tcp SRV 10 5 5060\n",
+			err:      "line 1: SRV record _sip._tcp.example.com: expected 4 fields (priority, weight, port, target), got 3",
+		},
+		"invalid data": {
+			contents: "_sip._tcp SRV 10 five 5060 sip\n",
+			err:      "line 1: SRV record _sip._tcp.example.com: weight must be an integer",
+		},
+		"invalid priority": {
+			contents: "@ MX high mail\n",
+			err:      `line 1: MX record example.com: invalid priority "high"`,
+		},
+		"include": {
+			contents: "$INCLUDE other.zone\n",
+			err:      "line 1: $INCLUDE is not supported",
+		},
+		"class": {
+			contents: "www CH A 192.0.2.1\n",
+			err:      "line 1: unsupported class CH",
+		},
+		"loc direction": {
+			contents: "loc LOC 52 22 23.000 X 4 53 32.000 E -2.00m\n",
+			err:      "line 1: LOC record loc.example.com: expected N or S after lat coordinates",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseZoneFile(test.contents, "example.com")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	tests := map[string]int{
+		"300":   300,
+		"1h":    3600,
+		"1h30m": 5400,
+		"1W":    604800,
+		"2d12h": 216000,
+	}
+
+	for input, expected := range tests {
+		ttl, err := parseTTL(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, ttl, input)
+	}
+
+	for _, input := range []string{"", "h", "1x", "10h5", "-1"} {
+		_, err := parseTTL(input)
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,192 @@
+package dns_zone_file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DNSZoneFileResource{}
+var _ resource.ResourceWithValidateConfig = &DNSZoneFileResource{}
+
+func NewResource() resource.Resource {
+	return &DNSZoneFileResource{}
+}
+
+// DNSZoneFileResource defines the resource implementation.
+type DNSZoneFileResource struct {
+	client *cloudflare.API
+}
+
+func (r *DNSZoneFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_file"
+}
+
+func (r *DNSZoneFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSZoneFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var zoneFile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("zone_file"), &zoneFile)...)
+	if resp.Diagnostics.HasError() || zoneFile.IsNull() || zoneFile.IsUnknown() {
+		return
+	}
+
+	// The zone name isn't known yet; the origin only affects how relative
+	// names are qualified, not whether the file is valid.
+	if _, err := parseZoneFile(zoneFile.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("zone_file"), "invalid zone file", err.Error())
+	}
+}
+
+func (r *DNSZoneFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DNSZoneFileModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	zone, err := r.client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read zone", err.Error())
+		return
+	}
+
+	records, err := parseZoneFile(data.ZoneFile.ValueString(), zone.Name)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("zone_file"), "invalid zone file", err.Error())
+		return
+	}
+
+	var created, skipped int64
+	for _, record := range records {
+		if !isWithinZone(record.Name, zone.Name) {
+			resp.Diagnostics.AddAttributeError(path.Root("zone_file"), "invalid zone file", fmt.Sprintf("line %d: %s is outside of zone %s", record.Line, record, zone.Name))
+			return
+		}
+
+		params := cloudflare.CreateDNSRecordParams{
+			Type:     record.Type,
+			Name:     record.Name,
+			Content:  record.Content,
+			Priority: record.Priority,
+			TTL:      record.TTL,
+			Proxied:  cloudflare.BoolPtr(record.Proxied),
+		}
+		if record.Data != nil {
+			params.Data = record.Data
+		}
+		if record.Proxied {
+			params.TTL = 1
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("creating DNS record %s from zone file line %d", record, record.Line))
+		_, err := r.client.CreateDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), params)
+		if err != nil {
+			if data.SkipExisting.ValueBool() && dnsRecordAlreadyExists(err) {
+				tflog.Debug(ctx, fmt.Sprintf("skipping existing DNS record %s", record))
+				skipped++
+				continue
+			}
+
+			resp.Diagnostics.AddError(
+				"failed to create DNS record from zone file",
+				fmt.Sprintf("line %d: %s: %s. %d records were created before the failure and are left in place.", record.Line, record, err, created),
+			)
+			return
+		}
+		created++
+	}
+
+	data.ID = data.ZoneID
+	data.RecordsCreated = types.Int64Value(created)
+	data.RecordsSkipped = types.Int64Value(skipped)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DNSZoneFileModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The seeded records aren't tracked, only the zone itself needs to exist.
+	_, err := r.client.ZoneDetails(ctx, data.ZoneID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("zone %s no longer exists", data.ZoneID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read zone", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DNSZoneFileModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only skip_existing can change in place and it only applies to seeding.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "removing DNS zone file from state, the seeded records are left in place")
+}
+
+func isWithinZone(name, zoneName string) bool {
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	return name == zoneName || strings.HasSuffix(name, "."+zoneName)
+}
+
+// dnsRecordAlreadyExists reports whether the API rejected a record because the
+// same record, or a conflicting one, already exists in the zone.
+func dnsRecordAlreadyExists(err error) bool {
+	var requestError *cloudflare.RequestError
+	if !errors.As(err, &requestError) {
+		return false
+	}
+
+	return requestError.InternalErrorCodeIs(81057) || requestError.InternalErrorCodeIs(81058)
+}
@@ -0,0 +1,53 @@
+package dns_zone_file_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareDNSZoneFile_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_dns_zone_file." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareDNSZoneFileBasic(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "skip_existing", "true"),
+					resource.TestCheckResourceAttr(resourceName, "records_created", "3"),
+					resource.TestCheckResourceAttr(resourceName, "records_skipped", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareDNSZoneFileBasic(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_dns_zone_file" "%[1]s" {
+  zone_id   = "%[2]s"
+  zone_file = <<-EOT
+    $TTL 3600
+    %[1]s-www  IN A     192.0.2.1
+    %[1]s-txt  IN TXT   "v=spf1 -all"
+    %[1]s-caa  IN CAA   0 issue "letsencrypt.org"
+  EOT
+}`, rnd, zoneID)
+}
@@ -0,0 +1,77 @@
+package dns_zone_file
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func (r *DNSZoneFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare resource that seeds the DNS records of a zone
+			from a BIND zone file. The zone file is parsed and validated during
+			plan and each record is created individually.
+
+			Seeding is a one-off operation: records aren't tracked once created
+			and destroying the resource leaves them in place. Use
+			` + "`cloudflare_dns_records`" + ` to keep managing them afterwards.
+
+			SOA records and NS records at the zone apex are ignored as they're
+			managed by Cloudflare. Proxied records can be marked with a
+			` + "`cf_tags=cf-proxied:true`" + ` comment, as found in zone
+			files exported by Cloudflare.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone_file": schema.StringAttribute{
+				MarkdownDescription: "The contents of the BIND zone file. Relative names are qualified with the zone name unless the file sets `$ORIGIN`. **Modifying this attribute will force creation of a new resource.**",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"skip_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether records that already exist in the zone are skipped. When `false`, an existing record fails the apply.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"records_created": schema.Int64Attribute{
+				MarkdownDescription: "The number of records created from the zone file.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"records_skipped": schema.Int64Attribute{
+				MarkdownDescription: "The number of records skipped because they already existed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
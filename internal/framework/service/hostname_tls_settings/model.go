@@ -0,0 +1,17 @@
+package hostname_tls_settings
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type HostnameTLSSettingsModel struct {
+	ZoneID    types.String                         `tfsdk:"zone_id"`
+	ID        types.String                         `tfsdk:"id"`
+	Hostnames map[string]*HostnameTLSSettingsEntry `tfsdk:"hostnames"`
+	BatchSize types.Int64                          `tfsdk:"batch_size"`
+}
+
+type HostnameTLSSettingsEntry struct {
+	MinTLSVersion types.String `tfsdk:"min_tls_version"`
+	Ciphers       types.Set    `tfsdk:"ciphers"`
+	HTTP2         types.String `tfsdk:"http2"`
+	TLS13         types.String `tfsdk:"tls_1_3"`
+}
@@ -0,0 +1,228 @@
+package hostname_tls_settings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HostnameTLSSettingsResource{}
+var _ resource.ResourceWithImportState = &HostnameTLSSettingsResource{}
+
+func NewResource() resource.Resource {
+	return &HostnameTLSSettingsResource{}
+}
+
+// HostnameTLSSettingsResource defines the resource implementation.
+type HostnameTLSSettingsResource struct {
+	client *cloudflare.API
+}
+
+func (r *HostnameTLSSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hostname_tls_settings"
+}
+
+func (r *HostnameTLSSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HostnameTLSSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *HostnameTLSSettingsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ops, errs, diags := r.reconcile(ctx, data, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An error would taint the resource and reset every hostname on the next
+	// apply, so failures are only warnings unless nothing was updated.
+	if len(errs) > 0 && len(errs) == len(ops) {
+		resp.Diagnostics.Append(hostnameFailureDiagnostics(ops, errs, false)...)
+		return
+	}
+	resp.Diagnostics.Append(hostnameFailureDiagnostics(ops, errs, true)...)
+
+	data.ID = data.ZoneID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostnameTLSSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *HostnameTLSSettingsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	current, err := listHostnameTLSSettings(ctx, r.client, zoneID)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("zone %s no longer exists", zoneID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read hostname TLS settings", err.Error())
+		return
+	}
+
+	// The hostnames are only unset after an import, in which case every
+	// hostname of the zone with a TLS setting is adopted.
+	if data.Hostnames == nil {
+		data.Hostnames = make(map[string]*HostnameTLSSettingsEntry, len(current))
+		for hostname := range current {
+			data.Hostnames[hostname] = nil
+		}
+	}
+
+	for hostname := range data.Hostnames {
+		data.Hostnames[hostname] = modelFromValues(current[hostname])
+	}
+
+	data.ID = data.ZoneID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostnameTLSSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *HostnameTLSSettingsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ops, errs, diags := r.reconcile(ctx, data, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(hostnameFailureDiagnostics(ops, errs, false)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostnameTLSSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *HostnameTLSSettingsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := &HostnameTLSSettingsModel{
+		ZoneID:    state.ZoneID,
+		BatchSize: state.BatchSize,
+		Hostnames: map[string]*HostnameTLSSettingsEntry{},
+	}
+	ops, errs, diags := r.reconcile(ctx, data, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(hostnameFailureDiagnostics(ops, errs, false)...)
+}
+
+func (r *HostnameTLSSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.ZoneIDSchemaKey), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.IDSchemaKey), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("batch_size"), 10)...)
+}
+
+// reconcile moves the hostnames from their state to the planned settings
+// and returns the operations it ran and the errors keyed by hostname. The
+// entry in data of a hostname which fails is replaced by the settings the API
+// now reports, so the next plan retries it.
+func (r *HostnameTLSSettingsResource) reconcile(ctx context.Context, data, state *HostnameTLSSettingsModel) (map[string][]settingOperation, map[string]error, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	desired := make(map[string]hostnameTLSValues, len(data.Hostnames))
+	for hostname, m := range data.Hostnames {
+		values, d := valuesFromModel(ctx, m)
+		diags.Append(d...)
+		desired[hostname] = values
+	}
+
+	current := make(map[string]hostnameTLSValues)
+	if state != nil {
+		for hostname, m := range state.Hostnames {
+			values, d := valuesFromModel(ctx, m)
+			diags.Append(d...)
+			current[hostname] = values
+		}
+	}
+
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	ops := planSettingOperations(desired, current)
+	errs := applySettingOperations(ctx, r.client, zoneID, ops, int(data.BatchSize.ValueInt64()))
+	if len(errs) == 0 {
+		return ops, errs, diags
+	}
+
+	refreshed, err := listHostnameTLSSettings(ctx, r.client, zoneID)
+	if err != nil {
+		diags.AddError("failed to read hostname TLS settings", err.Error())
+		return ops, errs, diags
+	}
+
+	for hostname := range errs {
+		if _, ok := data.Hostnames[hostname]; ok || len(refreshed[hostname]) > 0 {
+			data.Hostnames[hostname] = modelFromValues(refreshed[hostname])
+		}
+	}
+
+	return ops, errs, diags
+}
+
+// hostnameFailureDiagnostics reports each hostname which failed, as a warning
+// when the failed settings are retried by the next apply.
+func hostnameFailureDiagnostics(ops map[string][]settingOperation, errs map[string]error, warning bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, hostname := range sortedHostnames(ops) {
+		err, ok := errs[hostname]
+		if !ok {
+			continue
+		}
+
+		summary := fmt.Sprintf("failed to update TLS settings of %q", hostname)
+		if warning {
+			diags.AddAttributeWarning(path.Root("hostnames").AtMapKey(hostname), summary, fmt.Sprintf("%s\n\nThe settings will be applied on the next apply.", err))
+		} else {
+			diags.AddAttributeError(path.Root("hostnames").AtMapKey(hostname), summary, err.Error())
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,70 @@
+package hostname_tls_settings_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareHostnameTLSSettings_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_hostname_tls_settings." + rnd
+	first := fmt.Sprintf("%s-1.%s", rnd, domain)
+	second := fmt.Sprintf("%s-2.%s", rnd, domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareHostnameTLSSettingsConfig(rnd, zoneID, first, second, "1.2", "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "hostnames.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("hostnames.%s.min_tls_version", first), "1.2"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("hostnames.%s.http2", first), "off"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("hostnames.%s.ciphers.#", second), "2"),
+					resource.TestCheckNoResourceAttr(resourceName, fmt.Sprintf("hostnames.%s.min_tls_version", second)),
+				),
+			},
+			{
+				Config: testAccCloudflareHostnameTLSSettingsConfig(rnd, zoneID, first, second, "1.3", "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("hostnames.%s.min_tls_version", first), "1.3"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("hostnames.%s.http2", first), "on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareHostnameTLSSettingsConfig(rnd, zoneID, first, second, minTLSVersion, http2 string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_hostname_tls_settings" "%[1]s" {
+  zone_id = "%[2]s"
+
+  hostnames = {
+    "%[3]s" = {
+      min_tls_version = "%[5]s"
+      http2           = "%[6]s"
+      tls_1_3         = "on"
+    }
+    "%[4]s" = {
+      ciphers = ["ECDHE-RSA-AES128-GCM-SHA256", "AES128-GCM-SHA256"]
+    }
+  }
+}`, rnd, zoneID, first, second, minTLSVersion, http2)
+}
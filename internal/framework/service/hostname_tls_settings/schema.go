@@ -0,0 +1,97 @@
+package hostname_tls_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func onOffAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("%s %s", description, utils.RenderAvailableDocumentationValuesStringSlice([]string{"on", "off"})),
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("on", "off"),
+		},
+	}
+}
+
+func (r *HostnameTLSSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare resource to manage the per-hostname TLS
+			settings of many hostnames in a zone. Settings left unset on a
+			hostname are reset to the zone's setting, and changes are applied
+			in batches with failures reported per hostname.
+
+			Hostnames managed here should not also be managed with
+			` + "`cloudflare_hostname_tls_setting`" + ` or
+			` + "`cloudflare_hostname_tls_setting_ciphers`" + `. Importing the
+			resource adopts every hostname of the zone with a TLS setting.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				MarkdownDescription: "The number of hostnames to update concurrently. Defaults to `10`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"hostnames": schema.MapNestedAttribute{
+				MarkdownDescription: "The TLS settings of each hostname, keyed by hostname.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"min_tls_version": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The minimum TLS version accepted for the hostname. %s", utils.RenderAvailableDocumentationValuesStringSlice(minTLSVersions)),
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(minTLSVersions...),
+							},
+						},
+						"ciphers": schema.SetAttribute{
+							MarkdownDescription: "The cipher suites accepted for the hostname, in BoringSSL format.",
+							Optional:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"http2":   onOffAttribute("Whether HTTP/2 is enabled for the hostname."),
+						"tls_1_3": onOffAttribute("Whether TLS 1.3 is enabled for the hostname."),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,251 @@
+package hostname_tls_settings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	settingMinTLSVersion = "min_tls_version"
+	settingCiphers       = "ciphers"
+	settingHTTP2         = "http2"
+	settingTLS13         = "tls_1_3"
+)
+
+// settingNames is the order settings are applied and reported in.
+var settingNames = []string{settingMinTLSVersion, settingCiphers, settingHTTP2, settingTLS13}
+
+var minTLSVersions = []string{"1.0", "1.1", "1.2", "1.3"}
+
+// hostnameTLSValues holds the value of each setting configured on a hostname.
+// Ciphers are stored sorted and comma separated so values compare directly;
+// settings which are not configured have no key.
+type hostnameTLSValues map[string]string
+
+// settingOperation sets a single setting on a hostname, or removes it when
+// the value is empty.
+type settingOperation struct {
+	Setting string
+	Value   string
+}
+
+func joinCiphers(ciphers []string) string {
+	sorted := append([]string(nil), ciphers...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+func valuesFromModel(ctx context.Context, m *HostnameTLSSettingsEntry) (hostnameTLSValues, diag.Diagnostics) {
+	values := hostnameTLSValues{}
+	if m == nil {
+		return values, nil
+	}
+
+	for setting, value := range map[string]types.String{
+		settingMinTLSVersion: m.MinTLSVersion,
+		settingHTTP2:         m.HTTP2,
+		settingTLS13:         m.TLS13,
+	} {
+		if !value.IsNull() {
+			values[setting] = value.ValueString()
+		}
+	}
+
+	if !m.Ciphers.IsNull() {
+		var ciphers []string
+		if diags := m.Ciphers.ElementsAs(ctx, &ciphers, false); diags.HasError() {
+			return nil, diags
+		}
+		values[settingCiphers] = joinCiphers(ciphers)
+	}
+
+	return values, nil
+}
+
+func modelFromValues(values hostnameTLSValues) *HostnameTLSSettingsEntry {
+	optional := func(setting string) types.String {
+		if value, ok := values[setting]; ok {
+			return types.StringValue(value)
+		}
+		return types.StringNull()
+	}
+
+	m := &HostnameTLSSettingsEntry{
+		MinTLSVersion: optional(settingMinTLSVersion),
+		Ciphers:       types.SetNull(types.StringType),
+		HTTP2:         optional(settingHTTP2),
+		TLS13:         optional(settingTLS13),
+	}
+
+	if value, ok := values[settingCiphers]; ok {
+		ciphers := strings.Split(value, ",")
+		elements := make([]attr.Value, 0, len(ciphers))
+		for _, cipher := range ciphers {
+			elements = append(elements, types.StringValue(cipher))
+		}
+		m.Ciphers = types.SetValueMust(types.StringType, elements)
+	}
+
+	return m
+}
+
+// planSettingOperations returns the operations needed to move each hostname
+// from its current settings to the desired ones. Hostnames which are no
+// longer desired have all of their settings removed.
+func planSettingOperations(desired, current map[string]hostnameTLSValues) map[string][]settingOperation {
+	ops := make(map[string][]settingOperation)
+
+	hostnames := make(map[string]bool, len(desired)+len(current))
+	for hostname := range desired {
+		hostnames[hostname] = true
+	}
+	for hostname := range current {
+		hostnames[hostname] = true
+	}
+
+	for hostname := range hostnames {
+		for _, setting := range settingNames {
+			want, wanted := desired[hostname][setting]
+			have, has := current[hostname][setting]
+
+			switch {
+			case wanted && (!has || want != have):
+				ops[hostname] = append(ops[hostname], settingOperation{Setting: setting, Value: want})
+			case !wanted && has:
+				ops[hostname] = append(ops[hostname], settingOperation{Setting: setting})
+			}
+		}
+	}
+
+	return ops
+}
+
+// sortedHostnames returns the keys of the operations in a stable order.
+func sortedHostnames(ops map[string][]settingOperation) []string {
+	hostnames := make([]string, 0, len(ops))
+	for hostname := range ops {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+	return hostnames
+}
+
+// applySettingOperations applies the operations of up to size hostnames
+// concurrently and returns the errors keyed by hostname. The operations of a
+// single hostname run in order and stop at the first failure.
+func applySettingOperations(ctx context.Context, client *cloudflare.API, zoneID string, ops map[string][]settingOperation, size int) map[string]error {
+	return utils.ApplyInBatches(ctx, sortedHostnames(ops), size, func(ctx context.Context, hostname string) error {
+		for _, op := range ops[hostname] {
+			if err := applySettingOperation(ctx, client, zoneID, hostname, op); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func applySettingOperation(ctx context.Context, client *cloudflare.API, zoneID, hostname string, op settingOperation) error {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	var notFoundError *cloudflare.NotFoundError
+
+	var err error
+	switch {
+	case op.Setting == settingCiphers && op.Value != "":
+		_, err = client.UpdateHostnameTLSSettingCiphers(ctx, rc, cloudflare.UpdateHostnameTLSSettingCiphersParams{
+			Hostname: hostname,
+			Value:    strings.Split(op.Value, ","),
+		})
+	case op.Setting == settingCiphers:
+		_, err = client.DeleteHostnameTLSSettingCiphers(ctx, rc, cloudflare.DeleteHostnameTLSSettingCiphersParams{Hostname: hostname})
+		if errors.As(err, &notFoundError) {
+			err = nil
+		}
+	case op.Value != "":
+		_, err = client.UpdateHostnameTLSSetting(ctx, rc, cloudflare.UpdateHostnameTLSSettingParams{
+			Setting:  op.Setting,
+			Hostname: hostname,
+			Value:    op.Value,
+		})
+	default:
+		_, err = client.DeleteHostnameTLSSetting(ctx, rc, cloudflare.DeleteHostnameTLSSettingParams{
+			Setting:  op.Setting,
+			Hostname: hostname,
+		})
+		if errors.As(err, &notFoundError) {
+			err = nil
+		}
+	}
+
+	if err != nil {
+		action := "update"
+		if op.Value == "" {
+			action = "remove"
+		}
+		return fmt.Errorf("failed to %s %s: %w", action, op.Setting, err)
+	}
+
+	return nil
+}
+
+// listHostnameTLSSettings fetches every per-hostname TLS setting of the zone,
+// keyed by hostname.
+func listHostnameTLSSettings(ctx context.Context, client *cloudflare.API, zoneID string) (map[string]hostnameTLSValues, error) {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	result := make(map[string]hostnameTLSValues)
+	set := func(hostname, setting, value string) {
+		if result[hostname] == nil {
+			result[hostname] = hostnameTLSValues{}
+		}
+		result[hostname][setting] = value
+	}
+
+	for _, setting := range []string{settingMinTLSVersion, settingHTTP2, settingTLS13} {
+		for page := 1; ; page++ {
+			settings, resultInfo, err := client.ListHostnameTLSSettings(ctx, rc, cloudflare.ListHostnameTLSSettingsParams{
+				Setting:           setting,
+				PaginationOptions: cloudflare.PaginationOptions{Page: page, PerPage: 50},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing %s hostname TLS settings for zone %q: %w", setting, zoneID, err)
+			}
+
+			for _, s := range settings {
+				set(s.Hostname, setting, s.Value)
+			}
+
+			if len(settings) == 0 || page >= resultInfo.TotalPages {
+				break
+			}
+		}
+	}
+
+	for page := 1; ; page++ {
+		settings, resultInfo, err := client.ListHostnameTLSSettingsCiphers(ctx, rc, cloudflare.ListHostnameTLSSettingsCiphersParams{
+			PaginationOptions: cloudflare.PaginationOptions{Page: page, PerPage: 50},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing ciphers hostname TLS settings for zone %q: %w", zoneID, err)
+		}
+
+		for _, s := range settings {
+			if len(s.Value) > 0 {
+				set(s.Hostname, settingCiphers, joinCiphers(s.Value))
+			}
+		}
+
+		if len(settings) == 0 || page >= resultInfo.TotalPages {
+			break
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,82 @@
+package hostname_tls_settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValuesFromModel(t *testing.T) {
+	m := &HostnameTLSSettingsEntry{
+		MinTLSVersion: types.StringValue("1.2"),
+		Ciphers: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("ECDHE-RSA-AES128-GCM-SHA256"),
+			types.StringValue("AES128-GCM-SHA256"),
+		}),
+		HTTP2: types.StringNull(),
+		TLS13: types.StringValue("on"),
+	}
+
+	values, diags := valuesFromModel(context.Background(), m)
+	require.False(t, diags.HasError())
+	assert.Equal(t, hostnameTLSValues{
+		settingMinTLSVersion: "1.2",
+		settingCiphers:       "AES128-GCM-SHA256,ECDHE-RSA-AES128-GCM-SHA256",
+		settingTLS13:         "on",
+	}, values)
+
+	roundTrip, diags := valuesFromModel(context.Background(), modelFromValues(values))
+	require.False(t, diags.HasError())
+	assert.Equal(t, values, roundTrip)
+}
+
+func TestModelFromValuesUnset(t *testing.T) {
+	m := modelFromValues(nil)
+
+	assert.True(t, m.MinTLSVersion.IsNull())
+	assert.True(t, m.Ciphers.IsNull())
+	assert.True(t, m.HTTP2.IsNull())
+	assert.True(t, m.TLS13.IsNull())
+}
+
+func TestPlanSettingOperations(t *testing.T) {
+	desired := map[string]hostnameTLSValues{
+		"new.example.com": {settingMinTLSVersion: "1.2", settingHTTP2: "on"},
+		"changed.example.com": {
+			settingMinTLSVersion: "1.3",
+			settingCiphers:       "AES128-GCM-SHA256",
+		},
+		"unchanged.example.com": {settingTLS13: "on"},
+	}
+	current := map[string]hostnameTLSValues{
+		"changed.example.com": {
+			settingMinTLSVersion: "1.2",
+			settingCiphers:       "AES128-GCM-SHA256",
+			settingHTTP2:         "off",
+		},
+		"unchanged.example.com": {settingTLS13: "on"},
+		"removed.example.com":   {settingCiphers: "AES128-GCM-SHA256", settingTLS13: "off"},
+	}
+
+	ops := planSettingOperations(desired, current)
+
+	assert.Equal(t, map[string][]settingOperation{
+		"new.example.com": {
+			{Setting: settingMinTLSVersion, Value: "1.2"},
+			{Setting: settingHTTP2, Value: "on"},
+		},
+		"changed.example.com": {
+			{Setting: settingMinTLSVersion, Value: "1.3"},
+			{Setting: settingHTTP2},
+		},
+		"removed.example.com": {
+			{Setting: settingCiphers},
+			{Setting: settingTLS13},
+		},
+	}, ops)
+	assert.Equal(t, []string{"changed.example.com", "new.example.com", "removed.example.com"}, sortedHostnames(ops))
+}
@@ -0,0 +1,314 @@
+package origin_ca_certificate
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// originCARequestTypeKeyAlgorithms maps the request types to the key
+// algorithm their CSR must use.
+var originCARequestTypeKeyAlgorithms = map[string]string{
+	"origin-rsa": utils.KeyAlgorithmRSA,
+	"origin-ecc": utils.KeyAlgorithmECDSA,
+}
+
+var keyGenerationObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"algorithm":           types.StringType,
+	"rsa_bits":            types.Int64Type,
+	"ecdsa_curve":         types.StringType,
+	"common_name":         types.StringType,
+	"organization":        types.StringType,
+	"organizational_unit": types.StringType,
+	"country":             types.StringType,
+	"province":            types.StringType,
+	"locality":            types.StringType,
+	"rotation_triggers":   types.MapType{ElemType: types.StringType},
+}}
+
+// keyGeneration returns the `key_generation` block, or nil when the
+// certificate is issued for a supplied CSR.
+func keyGeneration(ctx context.Context, list types.List) (*OriginCACertificateKeyGenerationModel, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() || len(list.Elements()) == 0 {
+		return nil, nil
+	}
+
+	var blocks []*OriginCACertificateKeyGenerationModel
+	diags := list.ElementsAs(ctx, &blocks, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return blocks[0], diags
+}
+
+// normalizeKeyGeneration replaces the empty subject fields and rotation
+// triggers the SDKv2 implementation of the resource stored for unset values
+// with null values.
+func normalizeKeyGeneration(keyGeneration *OriginCACertificateKeyGenerationModel) {
+	for _, field := range []*types.String{
+		&keyGeneration.CommonName,
+		&keyGeneration.Organization,
+		&keyGeneration.OrganizationalUnit,
+		&keyGeneration.Country,
+		&keyGeneration.Province,
+		&keyGeneration.Locality,
+	} {
+		if !field.IsUnknown() && field.ValueString() == "" {
+			*field = types.StringNull()
+		}
+	}
+
+	if !keyGeneration.RotationTriggers.IsUnknown() && len(keyGeneration.RotationTriggers.Elements()) == 0 {
+		keyGeneration.RotationTriggers = types.MapNull(types.StringType)
+	}
+}
+
+// keyGenerationEqual reports whether two `key_generation` blocks generate
+// equivalent keys and requests.
+func keyGenerationEqual(a, b *OriginCACertificateKeyGenerationModel) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	x, y := *a, *b
+	normalizeKeyGeneration(&x)
+	normalizeKeyGeneration(&y)
+
+	return x.Algorithm.Equal(y.Algorithm) &&
+		x.RSABits.Equal(y.RSABits) &&
+		x.ECDSACurve.Equal(y.ECDSACurve) &&
+		x.CommonName.Equal(y.CommonName) &&
+		x.Organization.Equal(y.Organization) &&
+		x.OrganizationalUnit.Equal(y.OrganizationalUnit) &&
+		x.Country.Equal(y.Country) &&
+		x.Province.Equal(y.Province) &&
+		x.Locality.Equal(y.Locality) &&
+		x.RotationTriggers.Equal(y.RotationTriggers)
+}
+
+// generateOriginCACertificateRequest generates a private key and a CSR for
+// the hostnames from the `key_generation` settings.
+func generateOriginCACertificateRequest(keyGeneration *OriginCACertificateKeyGenerationModel, hostnames []string) (csr string, privateKey string, err error) {
+	key, err := utils.GeneratePrivateKey(
+		keyGeneration.Algorithm.ValueString(),
+		int(keyGeneration.RSABits.ValueInt64()),
+		keyGeneration.ECDSACurve.ValueString(),
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("error generating private key: %w", err)
+	}
+
+	subject := pkix.Name{CommonName: keyGeneration.CommonName.ValueString()}
+	if subject.CommonName == "" && len(hostnames) > 0 {
+		// Hostnames come from a set so pick the first one in a stable order.
+		sorted := append([]string{}, hostnames...)
+		sort.Strings(sorted)
+		subject.CommonName = sorted[0]
+	}
+	for _, field := range []struct {
+		value  types.String
+		target *[]string
+	}{
+		{keyGeneration.Organization, &subject.Organization},
+		{keyGeneration.OrganizationalUnit, &subject.OrganizationalUnit},
+		{keyGeneration.Country, &subject.Country},
+		{keyGeneration.Province, &subject.Province},
+		{keyGeneration.Locality, &subject.Locality},
+	} {
+		if v := field.value.ValueString(); v != "" {
+			*field.target = []string{v}
+		}
+	}
+
+	csr, err = utils.GenerateCertificateRequest(key, subject, hostnames)
+	if err != nil {
+		return "", "", err
+	}
+
+	privateKey, err = utils.EncodePrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	return csr, privateKey, nil
+}
+
+// renewalDue reports whether a certificate expiring on `expiresOn` is within
+// `minDays` days of its expiry.
+func renewalDue(expiresOn string, minDays int64, now time.Time) bool {
+	if expiresOn == "" {
+		return false
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresOn)
+	if err != nil {
+		return false
+	}
+
+	return now.After(expiry.AddDate(0, 0, -int(minDays)))
+}
+
+// reissueReason returns why the planned certificate can't keep the current
+// one, or an empty string when no new certificate needs to be issued.
+func reissueReason(ctx context.Context, plan, state *OriginCACertificateResourceModel, now time.Time) (string, diag.Diagnostics) {
+	switch {
+	case !plan.Hostnames.Equal(state.Hostnames):
+		return "hostnames changed", nil
+	case !plan.RequestType.Equal(state.RequestType):
+		return "request_type changed", nil
+	case !plan.RequestedValidity.Equal(state.RequestedValidity):
+		return "requested_validity changed", nil
+	case !plan.CSR.Equal(state.CSR):
+		return "csr changed", nil
+	case plan.KeyGeneration.IsUnknown():
+		return "key_generation changed", nil
+	}
+
+	planned, diags := keyGeneration(ctx, plan.KeyGeneration)
+	current, stateDiags := keyGeneration(ctx, state.KeyGeneration)
+	diags.Append(stateDiags...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if !keyGenerationEqual(planned, current) {
+		return "key_generation changed", diags
+	}
+
+	if renewalDue(state.ExpiresOn.ValueString(), plan.MinDaysForRenewal.ValueInt64(), now) {
+		return fmt.Sprintf("due for renewal, it expires on %s", state.ExpiresOn.ValueString()), diags
+	}
+
+	return "", diags
+}
+
+// markReissued marks the attributes set from the certificate as unknown
+// since they are only known once the new certificate is issued.
+func markReissued(plan *OriginCACertificateResourceModel) {
+	plan.ID = types.StringUnknown()
+	plan.Certificate = types.StringUnknown()
+	plan.ExpiresOn = types.StringUnknown()
+
+	if plan.KeyGeneration.IsUnknown() || len(plan.KeyGeneration.Elements()) > 0 {
+		plan.CSR = types.StringUnknown()
+		plan.PrivateKey = types.StringUnknown()
+	} else {
+		plan.PrivateKey = types.StringNull()
+	}
+}
+
+// issueOriginCACertificate issues a certificate for the planned hostnames,
+// generating the CSR and private key first when `key_generation` is used.
+func issueOriginCACertificate(ctx context.Context, client *cloudflare.API, data *OriginCACertificateResourceModel) (*cloudflare.OriginCACertificate, diag.Diagnostics) {
+	var hostnames []string
+	diags := data.Hostnames.ElementsAs(ctx, &hostnames, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	params := cloudflare.CreateOriginCertificateParams{
+		Hostnames:       hostnames,
+		RequestType:     data.RequestType.ValueString(),
+		RequestValidity: int(data.RequestedValidity.ValueInt64()),
+		CSR:             data.CSR.ValueString(),
+	}
+
+	keyGeneration, keyGenerationDiags := keyGeneration(ctx, data.KeyGeneration)
+	diags.Append(keyGenerationDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	privateKey := types.StringNull()
+	if keyGeneration != nil {
+		csr, key, err := generateOriginCACertificateRequest(keyGeneration, hostnames)
+		if err != nil {
+			diags.AddError("failed to generate certificate signing request", err.Error())
+			return nil, diags
+		}
+		params.CSR, privateKey = csr, types.StringValue(key)
+	}
+
+	cert, err := client.CreateOriginCACertificate(ctx, params)
+	if err != nil {
+		diags.AddError("failed to issue origin CA certificate", err.Error())
+		return nil, diags
+	}
+
+	data.CSR = types.StringValue(params.CSR)
+	data.PrivateKey = privateKey
+
+	return cert, diags
+}
+
+// revokeOriginCACertificate revokes the certificate, ignoring certificates
+// which no longer exist.
+func revokeOriginCACertificate(ctx context.Context, client *cloudflare.API, certID string) error {
+	_, err := client.RevokeOriginCACertificate(ctx, certID)
+	if err != nil && originCACertificateNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func originCACertificateNotFound(err error) bool {
+	return strings.Contains(err.Error(), "Failed to read certificate from Database")
+}
+
+// setOriginCACertificate sets the attributes returned by the API for an
+// issued certificate.
+func setOriginCACertificate(data *OriginCACertificateResourceModel, cert *cloudflare.OriginCACertificate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(cert.ID)
+	data.Certificate = types.StringValue(cert.Certificate)
+	data.ExpiresOn = types.StringValue(cert.ExpiresOn.Format(time.RFC3339))
+
+	chain, err := utils.ParseCertificateChain(cert.Certificate)
+	if err != nil {
+		diags.AddError("failed to parse origin CA certificate", err.Error())
+		return diags
+	}
+	data.RequestedValidity = types.Int64Value(int64(calculateRequestedValidityFromCertificate(chain[0])))
+
+	return diags
+}
+
+func calculateRequestedValidityFromCertificate(cert *x509.Certificate) int {
+	diff := cert.NotAfter.UTC().Sub(cert.NotBefore.UTC())
+	days := math.Round(diff.Hours() / 24)
+
+	validateDays := []float64{7, 30, 90, 365, 730, 1095, 5475}
+
+	// Find the closest matching requested validity (in days) to avoid possible leap second issue.
+	i := 0
+	d := math.Abs(days - validateDays[i])
+	distanceIdx := i
+	distance := d
+	for i < len(validateDays) {
+		d := math.Abs(validateDays[i] - days)
+		if d == 0 {
+			return int(days)
+		}
+
+		if d < distance {
+			distanceIdx = i
+			distance = d
+		}
+		i++
+	}
+	return int(validateDays[distanceIdx])
+}
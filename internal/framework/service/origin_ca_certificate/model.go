@@ -10,3 +10,30 @@ type CloudflareOriginCACertificateModel struct {
 	RequestType types.String `tfsdk:"request_type"`
 	RevokedAt   types.String `tfsdk:"revoked_at"`
 }
+
+type OriginCACertificateResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Certificate       types.String `tfsdk:"certificate"`
+	CSR               types.String `tfsdk:"csr"`
+	KeyGeneration     types.List   `tfsdk:"key_generation"`
+	PrivateKey        types.String `tfsdk:"private_key"`
+	ExpiresOn         types.String `tfsdk:"expires_on"`
+	Hostnames         types.Set    `tfsdk:"hostnames"`
+	RequestType       types.String `tfsdk:"request_type"`
+	RequestedValidity types.Int64  `tfsdk:"requested_validity"`
+	MinDaysForRenewal types.Int64  `tfsdk:"min_days_for_renewal"`
+	RevokeOnDestroy   types.Bool   `tfsdk:"revoke_on_destroy"`
+}
+
+type OriginCACertificateKeyGenerationModel struct {
+	Algorithm          types.String `tfsdk:"algorithm"`
+	RSABits            types.Int64  `tfsdk:"rsa_bits"`
+	ECDSACurve         types.String `tfsdk:"ecdsa_curve"`
+	CommonName         types.String `tfsdk:"common_name"`
+	Organization       types.String `tfsdk:"organization"`
+	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
+	Country            types.String `tfsdk:"country"`
+	Province           types.String `tfsdk:"province"`
+	Locality           types.String `tfsdk:"locality"`
+	RotationTriggers   types.Map    `tfsdk:"rotation_triggers"`
+}
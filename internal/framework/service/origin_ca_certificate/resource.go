@@ -0,0 +1,279 @@
+package origin_ca_certificate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OriginCACertificateResource{}
+var _ resource.ResourceWithImportState = &OriginCACertificateResource{}
+var _ resource.ResourceWithModifyPlan = &OriginCACertificateResource{}
+var _ resource.ResourceWithValidateConfig = &OriginCACertificateResource{}
+
+func NewResource() resource.Resource {
+	return &OriginCACertificateResource{}
+}
+
+// OriginCACertificateResource defines the resource implementation.
+type OriginCACertificateResource struct {
+	client *cloudflare.API
+}
+
+func (r *OriginCACertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_origin_ca_certificate"
+}
+
+func (r *OriginCACertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OriginCACertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *OriginCACertificateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyGenerationSet := data.KeyGeneration.IsUnknown() || len(data.KeyGeneration.Elements()) > 0
+	if data.CSR.IsNull() == !keyGenerationSet {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("csr"),
+			"invalid attribute combination",
+			"Exactly one of `csr` or `key_generation` must be set.",
+		)
+		return
+	}
+
+	if !data.CSR.IsNull() && !data.CSR.IsUnknown() {
+		if _, err := utils.ParseCertificateRequest(data.CSR.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("csr"), "invalid certificate signing request", err.Error())
+		}
+		return
+	}
+
+	keyGeneration, diags := keyGeneration(ctx, data.KeyGeneration)
+	resp.Diagnostics.Append(diags...)
+	if keyGeneration == nil || keyGeneration.Algorithm.IsUnknown() || data.RequestType.IsUnknown() {
+		return
+	}
+
+	// The default isn't applied to the configuration yet.
+	algorithm := keyGeneration.Algorithm.ValueString()
+	if keyGeneration.Algorithm.IsNull() {
+		algorithm = utils.KeyAlgorithmRSA
+	}
+
+	requestType := data.RequestType.ValueString()
+	if expected, ok := originCARequestTypeKeyAlgorithms[requestType]; ok && expected != algorithm {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key_generation").AtListIndex(0).AtName("algorithm"),
+			"invalid key algorithm",
+			fmt.Sprintf("The algorithm must be %q for request_type %q, got %q.", expected, requestType, algorithm),
+		)
+	}
+}
+
+// ModifyPlan reissues the certificate in place when any of the arguments it
+// is issued from change, or when it is due for renewal.
+func (r *OriginCACertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *OriginCACertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reason, diags := reissueReason(ctx, plan, state, time.Now())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || reason == "" {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("origin CA certificate %s will be reissued: %s", state.ID.ValueString(), reason))
+
+	markReissued(plan)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *OriginCACertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *OriginCACertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, diags := issueOriginCACertificate(ctx, r.client, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setOriginCACertificate(data, cert)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OriginCACertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OriginCACertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certID := data.ID.ValueString()
+	cert, err := r.client.GetOriginCACertificate(ctx, certID)
+	if err != nil {
+		if originCACertificateNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("origin CA certificate %s no longer exists", certID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read origin CA certificate", err.Error())
+		return
+	}
+
+	if !cert.RevokedAt.IsZero() {
+		tflog.Warn(ctx, fmt.Sprintf("origin CA certificate %s has been revoked", certID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	hostnames, diags := types.SetValueFrom(ctx, types.StringType, cert.Hostnames)
+	resp.Diagnostics.Append(diags...)
+	data.Hostnames = hostnames
+	data.RequestType = types.StringValue(cert.RequestType)
+
+	// The CSR is only set on import so that the configured value doesn't need
+	// to match the API's formatting.
+	if data.CSR.IsNull() && cert.CSR != "" {
+		data.CSR = types.StringValue(cert.CSR)
+	}
+
+	keyGeneration, diags := keyGeneration(ctx, data.KeyGeneration)
+	resp.Diagnostics.Append(diags...)
+	if keyGeneration != nil {
+		normalizeKeyGeneration(keyGeneration)
+		data.KeyGeneration, diags = types.ListValueFrom(ctx, keyGenerationObjectType, []*OriginCACertificateKeyGenerationModel{keyGeneration})
+		resp.Diagnostics.Append(diags...)
+	} else {
+		data.KeyGeneration = types.ListValueMust(keyGenerationObjectType, []attr.Value{})
+	}
+
+	if data.MinDaysForRenewal.IsNull() {
+		data.MinDaysForRenewal = types.Int64Value(0)
+	}
+	if data.RevokeOnDestroy.IsNull() {
+		data.RevokeOnDestroy = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(setOriginCACertificate(data, cert)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OriginCACertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *OriginCACertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The plan only leaves the ID unknown when the certificate is reissued,
+	// otherwise none of the changed arguments affect the certificate.
+	if !data.ID.IsUnknown() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// The previous certificate stays in the state until the new one is issued.
+	previousID := state.ID.ValueString()
+	cert, diags := issueOriginCACertificate(ctx, r.client, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setOriginCACertificate(data, cert)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RevokeOnDestroy.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("origin CA certificate %s was reissued as %s, the previous certificate is not revoked", previousID, cert.ID))
+		return
+	}
+
+	if err := revokeOriginCACertificate(ctx, r.client, previousID); err != nil {
+		resp.Diagnostics.AddWarning(
+			"failed to revoke previous origin CA certificate",
+			fmt.Sprintf("Origin CA certificate %s was reissued as %s but could not be revoked, revoke it manually: %s", previousID, cert.ID, err),
+		)
+	}
+}
+
+func (r *OriginCACertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OriginCACertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Certificates created before `revoke_on_destroy` existed don't have it
+	// in the state until they're refreshed, they were always revoked.
+	if !data.RevokeOnDestroy.IsNull() && !data.RevokeOnDestroy.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("origin CA certificate %s is removed from the state without being revoked", data.ID.ValueString()))
+		return
+	}
+
+	if err := revokeOriginCACertificate(ctx, r.client, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to revoke origin CA certificate", err.Error())
+	}
+}
+
+func (r *OriginCACertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(consts.IDSchemaKey), req, resp)
+}
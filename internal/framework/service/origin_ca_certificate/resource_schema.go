@@ -0,0 +1,193 @@
+package origin_ca_certificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	originCARequestTypes        = []string{"origin-rsa", "origin-ecc", "keyless-certificate"}
+	originCARequestedValidities = []int{7, 30, 90, 365, 730, 1095, 5475}
+)
+
+func int64Values(values []int) []int64 {
+	result := make([]int64, 0, len(values))
+	for _, v := range values {
+		result = append(result, int64(v))
+	}
+	return result
+}
+
+func computedStringAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *OriginCACertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare Origin CA certificate used to protect traffic
+			to your origin without involving a third party Certificate Authority.
+
+			Changes to ` + "`hostnames`, `request_type`, `requested_validity`, `csr` or `key_generation`" + `
+			reissue the certificate in place, the previous certificate is only
+			revoked once the new one has been issued. The certificate is also
+			reissued by any plan made less than ` + "`min_days_for_renewal`" + ` days
+			before it expires.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: computedStringAttribute(consts.IDSchemaDescription),
+			"certificate":      computedStringAttribute("The Origin CA certificate."),
+			"csr": schema.StringAttribute{
+				MarkdownDescription: "The Certificate Signing Request. Must be newline-encoded. Generated when `key_generation` is used. Exactly one of `csr` or `key_generation` must be set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded private key of the certificate, when generated with `key_generation`.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_on": computedStringAttribute("The datetime when the certificate will expire."),
+			"hostnames": schema.SetAttribute{
+				MarkdownDescription: "A list of hostnames or wildcard names bound to the certificate.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"request_type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The signature type desired on the certificate. %s", utils.RenderAvailableDocumentationValuesStringSlice(originCARequestTypes)),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(originCARequestTypes...),
+				},
+			},
+			"requested_validity": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of days for which the certificate should be valid. %s", utils.RenderAvailableDocumentationValuesIntSlice(originCARequestedValidities)),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(int64Values(originCARequestedValidities)...),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"min_days_for_renewal": schema.Int64Attribute{
+				MarkdownDescription: "Number of days prior to the expiry to reissue the certificate when a Terraform plan is made. Defaults to `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"revoke_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to revoke the certificate when it is destroyed or superseded by a reissued certificate. When `false` the certificate is only removed from the state and remains valid until it expires. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"key_generation": schema.ListNestedBlock{
+				MarkdownDescription: "Generate the private key and Certificate Signing Request locally for `hostnames` instead of supplying `csr`. The private key is stored in the state.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"algorithm": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The algorithm of the private key. Must match `request_type`: `RSA` for `origin-rsa` and `ECDSA` for `origin-ecc`. %s. Defaults to `%s`.", utils.RenderAvailableDocumentationValuesStringSlice(utils.KeyAlgorithms), utils.KeyAlgorithmRSA),
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(utils.KeyAlgorithmRSA),
+							Validators: []validator.String{
+								stringvalidator.OneOf(utils.KeyAlgorithms...),
+							},
+						},
+						"rsa_bits": schema.Int64Attribute{
+							MarkdownDescription: fmt.Sprintf("The size of RSA keys. %s. Defaults to `2048`.", utils.RenderAvailableDocumentationValuesIntSlice(utils.RSAKeySizes)),
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(2048),
+							Validators: []validator.Int64{
+								int64validator.OneOf(int64Values(utils.RSAKeySizes)...),
+							},
+						},
+						"ecdsa_curve": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The curve of ECDSA keys. %s. Defaults to `P256`.", utils.RenderAvailableDocumentationValuesStringSlice(utils.ECDSACurves)),
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("P256"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(utils.ECDSACurves...),
+							},
+						},
+						"common_name": schema.StringAttribute{
+							MarkdownDescription: "The common name of the request subject. Defaults to the first of `hostnames`.",
+							Optional:            true,
+						},
+						"organization": schema.StringAttribute{
+							MarkdownDescription: "The organization of the request subject.",
+							Optional:            true,
+						},
+						"organizational_unit": schema.StringAttribute{
+							MarkdownDescription: "The organizational unit of the request subject.",
+							Optional:            true,
+						},
+						"country": schema.StringAttribute{
+							MarkdownDescription: "The two-letter country code of the request subject.",
+							Optional:            true,
+						},
+						"province": schema.StringAttribute{
+							MarkdownDescription: "The state or province of the request subject.",
+							Optional:            true,
+						},
+						"locality": schema.StringAttribute{
+							MarkdownDescription: "The locality or city of the request subject.",
+							Optional:            true,
+						},
+						"rotation_triggers": schema.MapAttribute{
+							MarkdownDescription: "Arbitrary map of values that, when changed, will rotate the private key and reissue the certificate.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,272 @@
+package origin_ca_certificate_test
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func testAccOriginCACertificateRequest(t *testing.T, hostnames ...string) string {
+	key, err := utils.GeneratePrivateKey(utils.KeyAlgorithmRSA, 2048, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csr, err := utils.GenerateCertificateRequest(key, pkix.Name{CommonName: hostnames[0], Organization: []string{"Terraform Acceptance Tests"}}, hostnames)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return csr
+}
+
+func TestAccCloudflareOriginCACertificate_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := "cloudflare_origin_ca_certificate." + rnd
+	csr := testAccOriginCACertificateRequest(t, zoneName, "*."+zoneName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareOriginCACertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareOriginCACertificateConfigCSR(rnd, zoneName, csr, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(name, "id", regexp.MustCompile("^[0-9]+$")),
+					resource.TestCheckResourceAttr(name, "csr", csr),
+					resource.TestCheckResourceAttr(name, "hostnames.#", "2"),
+					resource.TestCheckResourceAttr(name, "request_type", "origin-rsa"),
+					resource.TestCheckResourceAttr(name, "requested_validity", "7"),
+					resource.TestCheckResourceAttr(name, "revoke_on_destroy", "true"),
+					resource.TestCheckNoResourceAttr(name, "private_key"),
+					resource.TestMatchResourceAttr(name, "certificate", regexp.MustCompile("BEGIN CERTIFICATE")),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"csr"},
+			},
+		},
+	})
+}
+
+func TestAccCloudflareOriginCACertificate_ReissueHostnames(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := "cloudflare_origin_ca_certificate." + rnd
+	var previousID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareOriginCACertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareOriginCACertificateConfigKeyGeneration(rnd, fmt.Sprintf("%q", zoneName), "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostnames.#", "1"),
+					resource.TestCheckResourceAttr(name, "request_type", "origin-ecc"),
+					resource.TestCheckResourceAttr(name, "key_generation.0.algorithm", "ECDSA"),
+					resource.TestMatchResourceAttr(name, "csr", regexp.MustCompile("BEGIN CERTIFICATE REQUEST")),
+					testAccCheckCloudflareOriginCACertificatePrivateKey(name),
+					testAccCheckCloudflareOriginCACertificateID(name, &previousID),
+				),
+			},
+			{
+				Config: testAccCloudflareOriginCACertificateConfigKeyGeneration(rnd, fmt.Sprintf("%q, \"*.%s\"", zoneName, zoneName), "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostnames.#", "2"),
+					testAccCheckCloudflareOriginCACertificatePrivateKey(name),
+					testAccCheckCloudflareOriginCACertificateReissued(name, &previousID),
+				),
+			},
+			{
+				Config: testAccCloudflareOriginCACertificateConfigKeyGeneration(rnd, fmt.Sprintf("%q, \"*.%s\"", zoneName, zoneName), "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareOriginCACertificatePrivateKey(name),
+					testAccCheckCloudflareOriginCACertificateReissued(name, &previousID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareOriginCACertificate_RevokeOnDestroy(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := "cloudflare_origin_ca_certificate." + rnd
+	csr := testAccOriginCACertificateRequest(t, zoneName)
+	var certID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			client, err := acctest.SharedClient()
+			if err != nil {
+				return err
+			}
+
+			cert, err := client.GetOriginCACertificate(context.Background(), certID)
+			if err != nil {
+				return err
+			}
+			if !cert.RevokedAt.IsZero() {
+				return fmt.Errorf("origin CA certificate %s was revoked on destroy", certID)
+			}
+
+			_, err = client.RevokeOriginCACertificate(context.Background(), certID)
+			return err
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareOriginCACertificateConfigCSR(rnd, zoneName, csr, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "revoke_on_destroy", "false"),
+					testAccCheckCloudflareOriginCACertificateID(name, &certID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareOriginCACertificateDestroy(s *terraform.State) error {
+	client, err := acctest.SharedClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Cloudflare client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_origin_ca_certificate" {
+			continue
+		}
+
+		cert, err := client.GetOriginCACertificate(context.Background(), rs.Primary.ID)
+		if err == nil && cert.RevokedAt == (time.Time{}) {
+			return fmt.Errorf("origin CA certificate still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudflareOriginCACertificateID(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccCheckCloudflareOriginCACertificateReissued checks the certificate
+// was replaced by a new one and that the previous certificate was revoked.
+func testAccCheckCloudflareOriginCACertificateReissued(name string, previousID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == *previousID {
+			return fmt.Errorf("expected the certificate to be reissued, got the same ID %s", rs.Primary.ID)
+		}
+
+		client, err := acctest.SharedClient()
+		if err != nil {
+			return err
+		}
+
+		previous, err := client.GetOriginCACertificate(context.Background(), *previousID)
+		if err == nil && previous.RevokedAt.IsZero() {
+			return fmt.Errorf("expected the previous certificate %s to be revoked", *previousID)
+		}
+
+		*previousID = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckCloudflareOriginCACertificatePrivateKey(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		chain, err := utils.ParseCertificateChain(rs.Primary.Attributes["certificate"])
+		if err != nil {
+			return err
+		}
+
+		key, err := utils.ParsePrivateKey(rs.Primary.Attributes["private_key"])
+		if err != nil {
+			return err
+		}
+
+		if !utils.PrivateKeyMatchesCertificate(key, chain[0]) {
+			return fmt.Errorf("private key does not match the certificate")
+		}
+
+		return nil
+	}
+}
+
+func testAccCloudflareOriginCACertificateConfigCSR(rnd, zoneName, csr string, revokeOnDestroy bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_origin_ca_certificate" "%[1]s" {
+  csr                = <<EOT
+%[3]sEOT
+  hostnames          = [ "%[2]s", "*.%[2]s" ]
+  request_type       = "origin-rsa"
+  requested_validity = 7
+  revoke_on_destroy  = %[4]t
+}
+`, rnd, zoneName, csr, revokeOnDestroy)
+}
+
+func testAccCloudflareOriginCACertificateConfigKeyGeneration(rnd, hostnames, epoch string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_origin_ca_certificate" "%[1]s" {
+  hostnames          = [ %[2]s ]
+  request_type       = "origin-ecc"
+  requested_validity = 7
+
+  key_generation {
+    algorithm    = "ECDSA"
+    organization = "Terraform Acceptance Tests"
+
+    rotation_triggers = {
+      epoch = "%[3]s"
+    }
+  }
+}
+`, rnd, hostnames, epoch)
+}
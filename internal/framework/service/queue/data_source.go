@@ -0,0 +1,97 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &QueuesDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &QueuesDataSource{}
+}
+
+// QueuesDataSource defines the data source implementation.
+type QueuesDataSource struct {
+	client *cloudflare.API
+}
+
+func (r *QueuesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queues"
+}
+
+func (r *QueuesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QueuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QueuesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter *regexp.Regexp
+	if data.Filter != nil && data.Filter.Name.ValueString() != "" {
+		var err error
+		filter, err = regexp.Compile(data.Filter.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter").AtName("name"), "invalid name filter", err.Error())
+			return
+		}
+	}
+
+	queues, _, err := r.client.ListQueues(ctx, cloudflare.AccountIdentifier(data.AccountID.ValueString()), cloudflare.ListQueuesParams{})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list Queues", err.Error())
+		return
+	}
+
+	data.ID = data.AccountID
+	data.Queues = make([]*QueueModel, 0, len(queues))
+	for _, queue := range queues {
+		if filter != nil && !filter.MatchString(queue.Name) {
+			continue
+		}
+
+		model := &QueueModel{
+			ID:         types.StringValue(queue.ID),
+			Name:       types.StringValue(queue.Name),
+			CreatedOn:  types.StringNull(),
+			ModifiedOn: types.StringNull(),
+		}
+		if queue.CreatedOn != nil {
+			model.CreatedOn = types.StringValue(queue.CreatedOn.Format(time.RFC3339))
+		}
+		if queue.ModifiedOn != nil {
+			model.ModifiedOn = types.StringValue(queue.ModifiedOn.Format(time.RFC3339))
+		}
+
+		data.Queues = append(data.Queues, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
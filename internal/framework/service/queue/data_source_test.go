@@ -0,0 +1,51 @@
+package queue_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudflareQueuesDataSource_Filter(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := "data.cloudflare_queues." + rnd
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareQueuesDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "queues.#", "1"),
+					resource.TestCheckResourceAttr(name, "queues.0.name", rnd),
+					resource.TestCheckResourceAttrSet(name, "queues.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareQueuesDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_queue" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+
+data "cloudflare_queues" "%[1]s" {
+  account_id = "%[2]s"
+
+  filter {
+    name = "^${cloudflare_queue.%[1]s.name}$"
+  }
+}`, rnd, accountID)
+}
@@ -0,0 +1,21 @@
+package queue
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type QueuesModel struct {
+	AccountID types.String       `tfsdk:"account_id"`
+	ID        types.String       `tfsdk:"id"`
+	Filter    *QueuesFilterModel `tfsdk:"filter"`
+	Queues    []*QueueModel      `tfsdk:"queues"`
+}
+
+type QueuesFilterModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type QueueModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	CreatedOn  types.String `tfsdk:"created_on"`
+	ModifiedOn types.String `tfsdk:"modified_on"`
+}
@@ -0,0 +1,374 @@
+package r2_bucket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/expanders"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/flatteners"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	secondsPerDay = 24 * 60 * 60
+
+	lifecycleConditionAge        = "Age"
+	storageClassInfrequentAccess = "InfrequentAccess"
+
+	defaultCustomDomainMinTLS = "1.0"
+)
+
+type r2LifecycleCondition struct {
+	Type   string `json:"type"`
+	MaxAge int64  `json:"maxAge,omitempty"`
+}
+
+type r2LifecycleTransition struct {
+	Condition r2LifecycleCondition `json:"condition"`
+}
+
+type r2StorageClassTransition struct {
+	Condition    r2LifecycleCondition `json:"condition"`
+	StorageClass string               `json:"storageClass"`
+}
+
+type r2LifecycleRule struct {
+	ID                              string                     `json:"id"`
+	Enabled                         bool                       `json:"enabled"`
+	Conditions                      r2LifecycleRuleConditions  `json:"conditions"`
+	DeleteObjectsTransition         *r2LifecycleTransition     `json:"deleteObjectsTransition,omitempty"`
+	AbortMultipartUploadsTransition *r2LifecycleTransition     `json:"abortMultipartUploadsTransition,omitempty"`
+	StorageClassTransitions         []r2StorageClassTransition `json:"storageClassTransitions,omitempty"`
+}
+
+type r2LifecycleRuleConditions struct {
+	Prefix string `json:"prefix"`
+}
+
+type r2LifecycleConfiguration struct {
+	Rules []r2LifecycleRule `json:"rules"`
+}
+
+type r2CORSAllowed struct {
+	Methods []string `json:"methods"`
+	Origins []string `json:"origins"`
+	Headers []string `json:"headers,omitempty"`
+}
+
+type r2CORSRule struct {
+	ID            string        `json:"id,omitempty"`
+	Allowed       r2CORSAllowed `json:"allowed"`
+	ExposeHeaders []string      `json:"exposeHeaders,omitempty"`
+	MaxAgeSeconds int64         `json:"maxAgeSeconds,omitempty"`
+}
+
+type r2CORSConfiguration struct {
+	Rules []r2CORSRule `json:"rules"`
+}
+
+type r2CustomDomain struct {
+	Domain  string `json:"domain"`
+	ZoneID  string `json:"zoneId,omitempty"`
+	Enabled bool   `json:"enabled"`
+	MinTLS  string `json:"minTLS,omitempty"`
+}
+
+type r2CustomDomains struct {
+	Domains []r2CustomDomain `json:"domains"`
+}
+
+type r2ManagedDomain struct {
+	Domain  string `json:"domain,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+func expandLifecycleRules(rules []*R2BucketLifecycleRuleModel) r2LifecycleConfiguration {
+	config := r2LifecycleConfiguration{Rules: make([]r2LifecycleRule, 0, len(rules))}
+
+	for _, rule := range rules {
+		r := r2LifecycleRule{
+			ID:         rule.ID.ValueString(),
+			Enabled:    rule.Enabled.ValueBool(),
+			Conditions: r2LifecycleRuleConditions{Prefix: rule.Prefix.ValueString()},
+		}
+
+		if days := rule.ExpirationDays.ValueInt64(); days > 0 {
+			r.DeleteObjectsTransition = &r2LifecycleTransition{
+				Condition: r2LifecycleCondition{Type: lifecycleConditionAge, MaxAge: days * secondsPerDay},
+			}
+		}
+
+		if days := rule.AbortMultipartUploadsDays.ValueInt64(); days > 0 {
+			r.AbortMultipartUploadsTransition = &r2LifecycleTransition{
+				Condition: r2LifecycleCondition{Type: lifecycleConditionAge, MaxAge: days * secondsPerDay},
+			}
+		}
+
+		if days := rule.TransitionToInfrequentAccessDays.ValueInt64(); days > 0 {
+			r.StorageClassTransitions = []r2StorageClassTransition{{
+				Condition:    r2LifecycleCondition{Type: lifecycleConditionAge, MaxAge: days * secondsPerDay},
+				StorageClass: storageClassInfrequentAccess,
+			}}
+		}
+
+		config.Rules = append(config.Rules, r)
+	}
+
+	return config
+}
+
+func flattenLifecycleRules(config r2LifecycleConfiguration) []*R2BucketLifecycleRuleModel {
+	rules := make([]*R2BucketLifecycleRuleModel, 0, len(config.Rules))
+
+	for _, r := range config.Rules {
+		rule := &R2BucketLifecycleRuleModel{
+			ID:                               types.StringValue(r.ID),
+			Enabled:                          types.BoolValue(r.Enabled),
+			Prefix:                           flatteners.String(r.Conditions.Prefix),
+			ExpirationDays:                   types.Int64Null(),
+			AbortMultipartUploadsDays:        types.Int64Null(),
+			TransitionToInfrequentAccessDays: types.Int64Null(),
+		}
+
+		if r.DeleteObjectsTransition != nil {
+			rule.ExpirationDays = flatteners.Int64(r.DeleteObjectsTransition.Condition.MaxAge / secondsPerDay)
+		}
+
+		if r.AbortMultipartUploadsTransition != nil {
+			rule.AbortMultipartUploadsDays = flatteners.Int64(r.AbortMultipartUploadsTransition.Condition.MaxAge / secondsPerDay)
+		}
+
+		for _, t := range r.StorageClassTransitions {
+			if t.StorageClass == storageClassInfrequentAccess {
+				rule.TransitionToInfrequentAccessDays = flatteners.Int64(t.Condition.MaxAge / secondsPerDay)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandCORSRules(ctx context.Context, rules []*R2BucketCORSRuleModel) r2CORSConfiguration {
+	config := r2CORSConfiguration{Rules: make([]r2CORSRule, 0, len(rules))}
+
+	for _, rule := range rules {
+		config.Rules = append(config.Rules, r2CORSRule{
+			ID: rule.ID.ValueString(),
+			Allowed: r2CORSAllowed{
+				Methods: expanders.StringSet(ctx, rule.AllowedMethods),
+				Origins: expanders.StringSet(ctx, rule.AllowedOrigins),
+				Headers: expanders.StringSet(ctx, rule.AllowedHeaders),
+			},
+			ExposeHeaders: expanders.StringSet(ctx, rule.ExposeHeaders),
+			MaxAgeSeconds: rule.MaxAgeSeconds.ValueInt64(),
+		})
+	}
+
+	return config
+}
+
+func flattenCORSRules(ctx context.Context, config r2CORSConfiguration) ([]*R2BucketCORSRuleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	rules := make([]*R2BucketCORSRuleModel, 0, len(config.Rules))
+
+	for _, r := range config.Rules {
+		rule := &R2BucketCORSRuleModel{
+			ID:             flatteners.String(r.ID),
+			AllowedMethods: flattenStringSet(ctx, r.Allowed.Methods, &diags),
+			AllowedOrigins: flattenStringSet(ctx, r.Allowed.Origins, &diags),
+			AllowedHeaders: flattenStringSet(ctx, r.Allowed.Headers, &diags),
+			ExposeHeaders:  flattenStringSet(ctx, r.ExposeHeaders, &diags),
+			MaxAgeSeconds:  flatteners.Int64(r.MaxAgeSeconds),
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, diags
+}
+
+// flattenStringSet returns a null set for empty values so that optional
+// attributes which are not configured do not show a difference.
+func flattenStringSet(ctx context.Context, in []string, diags *diag.Diagnostics) types.Set {
+	if len(in) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, in)
+	diags.Append(d...)
+
+	return set
+}
+
+func flattenCustomDomains(domains r2CustomDomains) []*R2BucketCustomDomainModel {
+	out := make([]*R2BucketCustomDomainModel, 0, len(domains.Domains))
+
+	for _, d := range domains.Domains {
+		minTLS := d.MinTLS
+		if minTLS == "" {
+			minTLS = defaultCustomDomainMinTLS
+		}
+
+		out = append(out, &R2BucketCustomDomainModel{
+			Domain:  types.StringValue(d.Domain),
+			ZoneID:  types.StringValue(d.ZoneID),
+			Enabled: types.BoolValue(d.Enabled),
+			MinTLS:  types.StringValue(minTLS),
+		})
+	}
+
+	return out
+}
+
+func bucketURI(data *R2BucketModel, suffix string) string {
+	return fmt.Sprintf("/accounts/%s/r2/buckets/%s/%s", data.AccountID.ValueString(), data.Name.ValueString(), suffix)
+}
+
+func (r *R2BucketResource) getLifecycleRules(ctx context.Context, data *R2BucketModel) (r2LifecycleConfiguration, error) {
+	var config r2LifecycleConfiguration
+
+	res, err := r.client.Raw(ctx, http.MethodGet, bucketURI(data, "lifecycle"), nil, nil)
+	if err != nil {
+		return config, err
+	}
+
+	err = json.Unmarshal(res.Result, &config)
+
+	return config, err
+}
+
+func (r *R2BucketResource) putLifecycleRules(ctx context.Context, data *R2BucketModel) error {
+	_, err := r.client.Raw(ctx, http.MethodPut, bucketURI(data, "lifecycle"), expandLifecycleRules(data.LifecycleRule), nil)
+
+	return err
+}
+
+func (r *R2BucketResource) getCORSRules(ctx context.Context, data *R2BucketModel) (r2CORSConfiguration, error) {
+	var config r2CORSConfiguration
+
+	res, err := r.client.Raw(ctx, http.MethodGet, bucketURI(data, "cors"), nil, nil)
+	if err != nil {
+		// Buckets without a CORS policy return a not found error.
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return config, nil
+		}
+
+		return config, err
+	}
+
+	err = json.Unmarshal(res.Result, &config)
+
+	return config, err
+}
+
+func (r *R2BucketResource) putCORSRules(ctx context.Context, data *R2BucketModel) error {
+	if len(data.CORSRule) == 0 {
+		_, err := r.client.Raw(ctx, http.MethodDelete, bucketURI(data, "cors"), nil, nil)
+		return err
+	}
+
+	_, err := r.client.Raw(ctx, http.MethodPut, bucketURI(data, "cors"), expandCORSRules(ctx, data.CORSRule), nil)
+
+	return err
+}
+
+func (r *R2BucketResource) getCustomDomains(ctx context.Context, data *R2BucketModel) (r2CustomDomains, error) {
+	var domains r2CustomDomains
+
+	res, err := r.client.Raw(ctx, http.MethodGet, bucketURI(data, "domains/custom"), nil, nil)
+	if err != nil {
+		return domains, err
+	}
+
+	err = json.Unmarshal(res.Result, &domains)
+
+	return domains, err
+}
+
+// updateCustomDomains reconciles the custom domains in the plan with the ones
+// previously managed in state.
+func (r *R2BucketResource) updateCustomDomains(ctx context.Context, plan, state []*R2BucketCustomDomainModel, data *R2BucketModel) error {
+	existing := make(map[string]*R2BucketCustomDomainModel, len(state))
+	for _, d := range state {
+		existing[d.Domain.ValueString()] = d
+	}
+
+	wanted := make(map[string]bool, len(plan))
+	for _, d := range plan {
+		domain := d.Domain.ValueString()
+		wanted[domain] = true
+
+		params := r2CustomDomain{
+			Domain:  domain,
+			ZoneID:  d.ZoneID.ValueString(),
+			Enabled: d.Enabled.ValueBool(),
+			MinTLS:  d.MinTLS.ValueString(),
+		}
+
+		current, ok := existing[domain]
+		switch {
+		case !ok:
+			if _, err := r.client.Raw(ctx, http.MethodPost, bucketURI(data, "domains/custom"), params, nil); err != nil {
+				return fmt.Errorf("failed to add custom domain %q: %w", domain, err)
+			}
+		case !current.ZoneID.Equal(d.ZoneID):
+			if _, err := r.client.Raw(ctx, http.MethodDelete, bucketURI(data, "domains/custom/"+domain), nil, nil); err != nil {
+				return fmt.Errorf("failed to remove custom domain %q: %w", domain, err)
+			}
+			if _, err := r.client.Raw(ctx, http.MethodPost, bucketURI(data, "domains/custom"), params, nil); err != nil {
+				return fmt.Errorf("failed to add custom domain %q: %w", domain, err)
+			}
+		case !current.Enabled.Equal(d.Enabled) || !current.MinTLS.Equal(d.MinTLS):
+			params.Domain, params.ZoneID = "", ""
+			if _, err := r.client.Raw(ctx, http.MethodPut, bucketURI(data, "domains/custom/"+domain), params, nil); err != nil {
+				return fmt.Errorf("failed to update custom domain %q: %w", domain, err)
+			}
+		}
+	}
+
+	for domain := range existing {
+		if wanted[domain] {
+			continue
+		}
+
+		if _, err := r.client.Raw(ctx, http.MethodDelete, bucketURI(data, "domains/custom/"+domain), nil, nil); err != nil {
+			return fmt.Errorf("failed to remove custom domain %q: %w", domain, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *R2BucketResource) getManagedDomain(ctx context.Context, data *R2BucketModel) (r2ManagedDomain, error) {
+	var domain r2ManagedDomain
+
+	res, err := r.client.Raw(ctx, http.MethodGet, bucketURI(data, "domains/managed"), nil, nil)
+	if err != nil {
+		return domain, err
+	}
+
+	err = json.Unmarshal(res.Result, &domain)
+
+	return domain, err
+}
+
+func (r *R2BucketResource) putManagedDomain(ctx context.Context, data *R2BucketModel) (r2ManagedDomain, error) {
+	var domain r2ManagedDomain
+
+	res, err := r.client.Raw(ctx, http.MethodPut, bucketURI(data, "domains/managed"), r2ManagedDomain{Enabled: data.R2DevEnabled.ValueBool()}, nil)
+	if err != nil {
+		return domain, err
+	}
+
+	err = json.Unmarshal(res.Result, &domain)
+
+	return domain, err
+}
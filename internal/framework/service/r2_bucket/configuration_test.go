@@ -0,0 +1,102 @@
+package r2_bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLifecycleRulesRoundTrip(t *testing.T) {
+	rules := []*R2BucketLifecycleRuleModel{
+		{
+			ID:                               types.StringValue("logs"),
+			Enabled:                          types.BoolValue(true),
+			Prefix:                           types.StringValue("logs/"),
+			ExpirationDays:                   types.Int64Value(30),
+			AbortMultipartUploadsDays:        types.Int64Value(1),
+			TransitionToInfrequentAccessDays: types.Int64Value(7),
+		},
+		{
+			ID:                               types.StringValue("uploads"),
+			Enabled:                          types.BoolValue(false),
+			Prefix:                           types.StringNull(),
+			ExpirationDays:                   types.Int64Null(),
+			AbortMultipartUploadsDays:        types.Int64Value(7),
+			TransitionToInfrequentAccessDays: types.Int64Null(),
+		},
+	}
+
+	config := expandLifecycleRules(rules)
+
+	if got := config.Rules[0].DeleteObjectsTransition.Condition.MaxAge; got != 30*secondsPerDay {
+		t.Errorf("expected expiration max age of %d seconds, got %d", 30*secondsPerDay, got)
+	}
+	if got := config.Rules[0].StorageClassTransitions[0].StorageClass; got != storageClassInfrequentAccess {
+		t.Errorf("expected storage class %q, got %q", storageClassInfrequentAccess, got)
+	}
+	if config.Rules[1].DeleteObjectsTransition != nil {
+		t.Error("expected no expiration for rule without expiration_days")
+	}
+
+	flattened := flattenLifecycleRules(config)
+	if len(flattened) != len(rules) {
+		t.Fatalf("expected %d rules, got %d", len(rules), len(flattened))
+	}
+
+	for i := range rules {
+		want, got := rules[i], flattened[i]
+		if !want.ID.Equal(got.ID) ||
+			!want.Enabled.Equal(got.Enabled) ||
+			!want.Prefix.Equal(got.Prefix) ||
+			!want.ExpirationDays.Equal(got.ExpirationDays) ||
+			!want.AbortMultipartUploadsDays.Equal(got.AbortMultipartUploadsDays) ||
+			!want.TransitionToInfrequentAccessDays.Equal(got.TransitionToInfrequentAccessDays) {
+			t.Errorf("rule %d did not round trip: want %+v, got %+v", i, want, got)
+		}
+	}
+}
+
+func TestCORSRulesRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	rules := []*R2BucketCORSRuleModel{
+		{
+			ID:             types.StringNull(),
+			AllowedMethods: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("GET"), types.StringValue("HEAD")}),
+			AllowedOrigins: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("https://example.com")}),
+			AllowedHeaders: types.SetNull(types.StringType),
+			ExposeHeaders:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ETag")}),
+			MaxAgeSeconds:  types.Int64Value(3600),
+		},
+	}
+
+	flattened, diags := flattenCORSRules(ctx, expandCORSRules(ctx, rules))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want, got := rules[0], flattened[0]
+	if !want.ID.Equal(got.ID) ||
+		!want.AllowedMethods.Equal(got.AllowedMethods) ||
+		!want.AllowedOrigins.Equal(got.AllowedOrigins) ||
+		!want.AllowedHeaders.Equal(got.AllowedHeaders) ||
+		!want.ExposeHeaders.Equal(got.ExposeHeaders) ||
+		!want.MaxAgeSeconds.Equal(got.MaxAgeSeconds) {
+		t.Errorf("rule did not round trip: want %+v, got %+v", want, got)
+	}
+}
+
+func TestFlattenCustomDomainsDefaultsMinTLS(t *testing.T) {
+	domains := flattenCustomDomains(r2CustomDomains{Domains: []r2CustomDomain{
+		{Domain: "assets.example.com", ZoneID: "zone", Enabled: true},
+		{Domain: "media.example.com", ZoneID: "zone", Enabled: false, MinTLS: "1.2"},
+	}})
+
+	if got := domains[0].MinTLS.ValueString(); got != defaultCustomDomainMinTLS {
+		t.Errorf("expected default min TLS %q, got %q", defaultCustomDomainMinTLS, got)
+	}
+	if got := domains[1].MinTLS.ValueString(); got != "1.2" {
+		t.Errorf("expected min TLS %q, got %q", "1.2", got)
+	}
+}
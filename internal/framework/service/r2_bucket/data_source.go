@@ -0,0 +1,124 @@
+package r2_bucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/flatteners"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &R2BucketsDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &R2BucketsDataSource{}
+}
+
+// R2BucketsDataSource defines the data source implementation.
+type R2BucketsDataSource struct {
+	client *cloudflare.API
+}
+
+func (r *R2BucketsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_r2_buckets"
+}
+
+func (r *R2BucketsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *R2BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data R2BucketsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter *regexp.Regexp
+	if data.Filter != nil && data.Filter.Name.ValueString() != "" {
+		var err error
+		filter, err = regexp.Compile(data.Filter.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter").AtName("name"), "invalid name filter", err.Error())
+			return
+		}
+	}
+
+	buckets, err := r.listR2Buckets(ctx, data.AccountID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list R2 buckets", err.Error())
+		return
+	}
+
+	data.ID = data.AccountID
+	data.Buckets = make([]*R2BucketsBucketModel, 0, len(buckets))
+	for _, bucket := range buckets {
+		if filter != nil && !filter.MatchString(bucket.Name) {
+			continue
+		}
+
+		model := &R2BucketsBucketModel{
+			Name:         types.StringValue(bucket.Name),
+			Location:     flatteners.String(bucket.Location),
+			CreationDate: types.StringNull(),
+		}
+		if bucket.CreationDate != nil {
+			model.CreationDate = types.StringValue(bucket.CreationDate.Format(time.RFC3339))
+		}
+
+		data.Buckets = append(data.Buckets, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listR2Buckets lists every bucket of the account. `ListR2Buckets` only
+// returns the first page and drops the cursor, so the pages are requested
+// directly until the API stops returning a cursor.
+func (r *R2BucketsDataSource) listR2Buckets(ctx context.Context, accountID string) ([]cloudflare.R2Bucket, error) {
+	var buckets []cloudflare.R2Bucket
+
+	query := url.Values{}
+	query.Set("per_page", "1000")
+	for {
+		res, err := r.client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/r2/buckets?%s", accountID, query.Encode()), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page cloudflare.R2Buckets
+		if err := json.Unmarshal(res.Result, &page); err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, page.Buckets...)
+
+		if res.ResultInfo == nil || res.ResultInfo.Cursor == "" {
+			return buckets, nil
+		}
+		query.Set("cursor", res.ResultInfo.Cursor)
+	}
+}
@@ -0,0 +1,60 @@
+package r2_bucket
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (r *R2BucketsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Use this data source to look up [R2 buckets](https://developers.cloudflare.com/r2/) in an
+			account.
+		`),
+		Attributes: map[string]schema.Attribute{
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.AccountIDSchemaDescription,
+				Required:            true,
+			},
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+			},
+			"buckets": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of R2 buckets matching the filter.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the R2 bucket.",
+						},
+						"location": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The location of the R2 bucket.",
+						},
+						"creation_date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the R2 bucket was created.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "One or more values used to look up R2 buckets. If more than one value is given all values must match in order to be included.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A regular expression matching the name of the R2 buckets to lookup.",
+					},
+				},
+			},
+		},
+	}
+}
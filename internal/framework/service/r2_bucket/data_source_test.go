@@ -0,0 +1,51 @@
+package r2_bucket_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudflareR2BucketsDataSource_Filter(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := "data.cloudflare_r2_buckets." + rnd
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "buckets.#", "1"),
+					resource.TestCheckResourceAttr(name, "buckets.0.name", rnd),
+					resource.TestCheckResourceAttrSet(name, "buckets.0.creation_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareR2BucketsDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_r2_bucket" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+
+data "cloudflare_r2_buckets" "%[1]s" {
+  account_id = "%[2]s"
+
+  filter {
+    name = "^${cloudflare_r2_bucket.%[1]s.name}$"
+  }
+}`, rnd, accountID)
+}
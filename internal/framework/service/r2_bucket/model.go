@@ -3,8 +3,55 @@ package r2_bucket
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type R2BucketModel struct {
-	AccountID types.String `tfsdk:"account_id"`
-	Name      types.String `tfsdk:"name"`
-	ID        types.String `tfsdk:"id"`
-	Location  types.String `tfsdk:"location"`
+	AccountID     types.String                  `tfsdk:"account_id"`
+	Name          types.String                  `tfsdk:"name"`
+	ID            types.String                  `tfsdk:"id"`
+	Location      types.String                  `tfsdk:"location"`
+	R2DevEnabled  types.Bool                    `tfsdk:"r2_dev_enabled"`
+	R2DevDomain   types.String                  `tfsdk:"r2_dev_domain"`
+	LifecycleRule []*R2BucketLifecycleRuleModel `tfsdk:"lifecycle_rule"`
+	CORSRule      []*R2BucketCORSRuleModel      `tfsdk:"cors_rule"`
+	CustomDomain  []*R2BucketCustomDomainModel  `tfsdk:"custom_domain"`
+}
+
+type R2BucketLifecycleRuleModel struct {
+	ID                               types.String `tfsdk:"id"`
+	Enabled                          types.Bool   `tfsdk:"enabled"`
+	Prefix                           types.String `tfsdk:"prefix"`
+	ExpirationDays                   types.Int64  `tfsdk:"expiration_days"`
+	AbortMultipartUploadsDays        types.Int64  `tfsdk:"abort_multipart_uploads_days"`
+	TransitionToInfrequentAccessDays types.Int64  `tfsdk:"transition_to_infrequent_access_days"`
+}
+
+type R2BucketCORSRuleModel struct {
+	ID             types.String `tfsdk:"id"`
+	AllowedMethods types.Set    `tfsdk:"allowed_methods"`
+	AllowedOrigins types.Set    `tfsdk:"allowed_origins"`
+	AllowedHeaders types.Set    `tfsdk:"allowed_headers"`
+	ExposeHeaders  types.Set    `tfsdk:"expose_headers"`
+	MaxAgeSeconds  types.Int64  `tfsdk:"max_age_seconds"`
+}
+
+type R2BucketCustomDomainModel struct {
+	Domain  types.String `tfsdk:"domain"`
+	ZoneID  types.String `tfsdk:"zone_id"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	MinTLS  types.String `tfsdk:"min_tls"`
+}
+
+type R2BucketsModel struct {
+	AccountID types.String            `tfsdk:"account_id"`
+	ID        types.String            `tfsdk:"id"`
+	Filter    *R2BucketsFilterModel   `tfsdk:"filter"`
+	Buckets   []*R2BucketsBucketModel `tfsdk:"buckets"`
+}
+
+type R2BucketsFilterModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type R2BucketsBucketModel struct {
+	Name         types.String `tfsdk:"name"`
+	Location     types.String `tfsdk:"location"`
+	CreationDate types.String `tfsdk:"creation_date"`
 }
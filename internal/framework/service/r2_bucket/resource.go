@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/flatteners"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -69,6 +71,10 @@ func (r *R2BucketResource) Create(ctx context.Context, req resource.CreateReques
 	data.ID = types.StringValue(r2Bucket.Name)
 	data.Name = types.StringValue(r2Bucket.Name)
 	data.Location = types.StringValue(r2Bucket.Location)
+
+	if !r.applyConfiguration(ctx, data, nil, &resp.Diagnostics) {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -89,6 +95,12 @@ func (r *R2BucketResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data.ID = types.StringValue(r2Bucket.Name)
 	data.Name = types.StringValue(r2Bucket.Name)
 	data.Location = types.StringValue(r2Bucket.Location)
+
+	resp.Diagnostics.Append(r.readConfiguration(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -101,7 +113,18 @@ func (r *R2BucketResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	resp.Diagnostics.AddError("failed to update R2 bucket", "Not implemented")
+	var state *R2BucketModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.applyConfiguration(ctx, data, state, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *R2BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -134,3 +157,110 @@ func (r *R2BucketResource) ImportState(ctx context.Context, req resource.ImportS
 		ctx, path.Root("id"), idparts[1],
 	)...)
 }
+
+// applyConfiguration applies the configuration of the plan. When that fails
+// the configuration is read back from the API instead, so the state records
+// what was applied and the next plan retries the rest. It reports whether
+// data can be saved to the state.
+func (r *R2BucketResource) applyConfiguration(ctx context.Context, data, state *R2BucketModel, diags *diag.Diagnostics) bool {
+	updateDiags := r.updateConfiguration(ctx, data, state)
+	diags.Append(updateDiags...)
+	if !updateDiags.HasError() {
+		return true
+	}
+
+	readDiags := r.readConfiguration(ctx, data)
+	diags.Append(readDiags...)
+
+	return !readDiags.HasError()
+}
+
+// readConfiguration sets the lifecycle rules, CORS rules, custom domains and
+// public access settings of the bucket from the API.
+func (r *R2BucketResource) readConfiguration(ctx context.Context, data *R2BucketModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	lifecycle, err := r.getLifecycleRules(ctx, data)
+	if err != nil {
+		diags.AddError("failed reading R2 bucket lifecycle rules", err.Error())
+		return diags
+	}
+
+	cors, err := r.getCORSRules(ctx, data)
+	if err != nil {
+		diags.AddError("failed reading R2 bucket CORS rules", err.Error())
+		return diags
+	}
+
+	domains, err := r.getCustomDomains(ctx, data)
+	if err != nil {
+		diags.AddError("failed reading R2 bucket custom domains", err.Error())
+		return diags
+	}
+
+	managedDomain, err := r.getManagedDomain(ctx, data)
+	if err != nil {
+		diags.AddError("failed reading R2 bucket public access", err.Error())
+		return diags
+	}
+
+	corsRules, corsDiags := flattenCORSRules(ctx, cors)
+	diags.Append(corsDiags...)
+
+	data.LifecycleRule = flattenLifecycleRules(lifecycle)
+	data.CORSRule = corsRules
+	data.CustomDomain = flattenCustomDomains(domains)
+	data.R2DevEnabled = types.BoolValue(managedDomain.Enabled)
+	data.R2DevDomain = flatteners.String(managedDomain.Domain)
+
+	return diags
+}
+
+// updateConfiguration applies the lifecycle rules, CORS rules, custom domains
+// and public access settings of the plan. `state` is nil for new buckets.
+func (r *R2BucketResource) updateConfiguration(ctx context.Context, data, state *R2BucketModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// New buckets come with a default lifecycle rule, which is replaced by
+	// the configured rules.
+	created := state == nil
+	if created {
+		state = &R2BucketModel{R2DevEnabled: types.BoolValue(false)}
+	}
+
+	if created || len(data.LifecycleRule) > 0 || len(state.LifecycleRule) > 0 {
+		if err := r.putLifecycleRules(ctx, data); err != nil {
+			diags.AddError("failed to update R2 bucket lifecycle rules", err.Error())
+			return diags
+		}
+	}
+
+	if len(data.CORSRule) > 0 || len(state.CORSRule) > 0 {
+		if err := r.putCORSRules(ctx, data); err != nil {
+			diags.AddError("failed to update R2 bucket CORS rules", err.Error())
+			return diags
+		}
+	}
+
+	if err := r.updateCustomDomains(ctx, data.CustomDomain, state.CustomDomain, data); err != nil {
+		diags.AddError("failed to update R2 bucket custom domains", err.Error())
+		return diags
+	}
+
+	var managedDomain r2ManagedDomain
+	var err error
+	// Public access is left as it is when `r2_dev_enabled` isn't configured.
+	if !data.R2DevEnabled.IsUnknown() && !data.R2DevEnabled.Equal(state.R2DevEnabled) {
+		managedDomain, err = r.putManagedDomain(ctx, data)
+	} else {
+		managedDomain, err = r.getManagedDomain(ctx, data)
+	}
+	if err != nil {
+		diags.AddError("failed to update R2 bucket public access", err.Error())
+		return diags
+	}
+	data.R2DevEnabled = types.BoolValue(managedDomain.Enabled)
+	data.R2DevDomain = flatteners.String(managedDomain.Domain)
+
+	return diags
+}
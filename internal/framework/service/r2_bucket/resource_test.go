@@ -147,6 +147,46 @@ func TestAccCloudflareR2Bucket_Minimum(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareR2Bucket_Configuration(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	resourceName := "cloudflare_r2_bucket." + rnd
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareR2BucketConfiguration(rnd, accountID, 30, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "r2_dev_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "r2_dev_domain"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.expiration_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.abort_multipart_uploads_days", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.transition_to_infrequent_access_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.max_age_seconds", "3600"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareR2BucketConfiguration(rnd, accountID, 60, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "r2_dev_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.expiration_days", "60"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
 func testAccCheckCloudflareR2BucketMinimum(rnd, accountID string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_r2_bucket" "%[1]s" {
@@ -163,3 +203,26 @@ func testAccCheckCloudflareR2BucketBasic(rnd, accountID string) string {
 	location   = "ENAM"
   }`, rnd, accountID)
 }
+
+func testAccCheckCloudflareR2BucketConfiguration(rnd, accountID string, expirationDays int, r2DevEnabled bool) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_r2_bucket" "%[1]s" {
+    account_id     = "%[2]s"
+    name           = "%[1]s"
+    r2_dev_enabled = %[4]t
+
+    lifecycle_rule {
+      id                                   = "logs"
+      prefix                               = "logs/"
+      expiration_days                      = %[3]d
+      abort_multipart_uploads_days         = 1
+      transition_to_infrequent_access_days = 7
+    }
+
+    cors_rule {
+      allowed_methods = ["GET", "HEAD"]
+      allowed_origins = ["https://example.com"]
+      max_age_seconds = 3600
+    }
+  }`, rnd, accountID, expirationDays, r2DevEnabled)
+}
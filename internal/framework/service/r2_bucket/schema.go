@@ -2,12 +2,28 @@ package r2_bucket
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	corsMethods    = []string{"GET", "PUT", "POST", "DELETE", "HEAD"}
+	minTLSVersions = []string{"1.0", "1.1", "1.2", "1.3"}
 )
 
 func (r *R2BucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -43,6 +59,136 @@ func (r *R2BucketResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"r2_dev_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether public access through the managed `r2.dev` subdomain is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"r2_dev_domain": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The managed `r2.dev` subdomain of the bucket.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"lifecycle_rule": schema.ListNestedBlock{
+				MarkdownDescription: "Object lifecycle rules of the bucket. Rules which are not configured, including the default rule of new buckets, are removed.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Unique identifier of the rule.",
+						},
+						"enabled": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+							MarkdownDescription: "Whether the rule is active.",
+						},
+						"prefix": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Only apply the rule to objects with keys starting with this prefix.",
+						},
+						"expiration_days": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Delete objects this many days after they were uploaded.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"abort_multipart_uploads_days": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Abort incomplete multipart uploads this many days after they were started.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"transition_to_infrequent_access_days": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Move objects to the Infrequent Access storage class this many days after they were uploaded.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"cors_rule": schema.ListNestedBlock{
+				MarkdownDescription: "CORS rules of the bucket.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Identifier of the rule.",
+						},
+						"allowed_methods": schema.SetAttribute{
+							Required:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: fmt.Sprintf("HTTP methods allowed for cross-origin requests. %s", utils.RenderAvailableDocumentationValuesStringSlice(corsMethods)),
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(corsMethods...)),
+							},
+						},
+						"allowed_origins": schema.SetAttribute{
+							Required:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Origins allowed to make cross-origin requests.",
+						},
+						"allowed_headers": schema.SetAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Request headers allowed in cross-origin requests.",
+						},
+						"expose_headers": schema.SetAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Response headers exposed to the requesting origin.",
+						},
+						"max_age_seconds": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "How long browsers may cache the preflight response.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"custom_domain": schema.SetNestedBlock{
+				MarkdownDescription: "Custom domains serving the bucket publicly.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The custom domain.",
+						},
+						consts.ZoneIDSchemaKey: schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The zone identifier the custom domain belongs to.",
+						},
+						"enabled": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+							MarkdownDescription: "Whether the custom domain serves the bucket.",
+						},
+						"min_tls": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(defaultCustomDomainMinTLS),
+							MarkdownDescription: fmt.Sprintf("The minimum TLS version for the custom domain. %s", utils.RenderAvailableDocumentationValuesStringSlice(minTLSVersions)),
+							Validators: []validator.String{
+								stringvalidator.OneOf(minTLSVersions...),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
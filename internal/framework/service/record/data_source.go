@@ -0,0 +1,128 @@
+package record
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/flatteners"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// recordsPerPage is the largest page size supported by the DNS record list
+// API.
+const recordsPerPage = 5000
+
+var _ datasource.DataSource = &RecordsDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &RecordsDataSource{}
+}
+
+// RecordsDataSource defines the data source implementation.
+type RecordsDataSource struct {
+	client *cloudflare.API
+}
+
+func (r *RecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_records"
+}
+
+func (r *RecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RecordsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	if data.Filter != nil && !data.Filter.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Filter.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	query := recordsQuery(data.Filter, tags)
+	query.Set("per_page", strconv.Itoa(recordsPerPage))
+
+	var records []cloudflare.DNSRecord
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+
+		res, err := r.client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/dns_records?%s", data.ZoneID.ValueString(), query.Encode()), nil, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to list DNS records", err.Error())
+			return
+		}
+
+		var pageRecords []cloudflare.DNSRecord
+		if err := json.Unmarshal(res.Result, &pageRecords); err != nil {
+			resp.Diagnostics.AddError("failed to parse DNS records", err.Error())
+			return
+		}
+		records = append(records, pageRecords...)
+
+		if res.ResultInfo == nil || page >= res.ResultInfo.TotalPages {
+			break
+		}
+	}
+
+	data.ID = data.ZoneID
+	data.Records = make([]*RecordModel, 0, len(records))
+	for _, record := range records {
+		priority := types.Int64Null()
+		if record.Priority != nil {
+			priority = types.Int64Value(int64(*record.Priority))
+		}
+
+		tags := make([]attr.Value, 0, len(record.Tags))
+		for _, tag := range record.Tags {
+			tags = append(tags, types.StringValue(tag))
+		}
+
+		data.Records = append(data.Records, &RecordModel{
+			ID:         types.StringValue(record.ID),
+			Name:       types.StringValue(record.Name),
+			Type:       types.StringValue(record.Type),
+			Content:    types.StringValue(record.Content),
+			Proxied:    flatteners.Bool(record.Proxied),
+			Proxiable:  types.BoolValue(record.Proxiable),
+			TTL:        types.Int64Value(int64(record.TTL)),
+			Priority:   priority,
+			Comment:    flatteners.String(record.Comment),
+			Tags:       flatteners.StringSet(tags),
+			Locked:     types.BoolValue(record.Locked),
+			CreatedOn:  types.StringValue(record.CreatedOn.Format(time.RFC3339)),
+			ModifiedOn: types.StringValue(record.ModifiedOn.Format(time.RFC3339)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
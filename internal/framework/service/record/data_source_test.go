@@ -0,0 +1,68 @@
+package record_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudflareRecordsDataSource_Filter(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := "data.cloudflare_records." + rnd
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordsDataSourceConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "1"),
+					resource.TestCheckResourceAttr(name, "records.0.name", rnd+"-a."+domain),
+					resource.TestCheckResourceAttr(name, "records.0.type", "A"),
+					resource.TestCheckResourceAttr(name, "records.0.content", "192.0.2.1"),
+					resource.TestCheckResourceAttr(name, "records.0.tags.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "records.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRecordsDataSourceConfig(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[1]s_a" {
+  zone_id = "%[2]s"
+  name    = "%[1]s-a"
+  type    = "A"
+  value   = "192.0.2.1"
+  tags    = ["owner:%[1]s"]
+}
+
+resource "cloudflare_record" "%[1]s_b" {
+  zone_id = "%[2]s"
+  name    = "%[1]s-b"
+  type    = "A"
+  value   = "192.0.2.2"
+}
+
+data "cloudflare_records" "%[1]s" {
+  zone_id = "%[2]s"
+
+  filter {
+    name_starts_with = "%[1]s"
+    tags             = ["owner:%[1]s"]
+  }
+
+  depends_on = [cloudflare_record.%[1]s_a, cloudflare_record.%[1]s_b]
+}`, rnd, zoneID)
+}
@@ -0,0 +1,46 @@
+package record
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// recordsQuery builds the DNS record list API query for the filter. Tags in
+// the form "name:value" must match exactly while a bare tag name only needs
+// to be present.
+func recordsQuery(filter *RecordsFilterModel, tags []string) url.Values {
+	query := url.Values{}
+	if filter == nil {
+		return query
+	}
+
+	set := func(key, value string) {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+
+	set("name.exact", strings.TrimSuffix(filter.Name.ValueString(), "."))
+	set("name.contains", filter.NameContains.ValueString())
+	set("name.startswith", filter.NameStartsWith.ValueString())
+	set("type", filter.Type.ValueString())
+	set("content.exact", filter.Content.ValueString())
+	set("comment.exact", filter.Comment.ValueString())
+	set("tag_match", filter.TagMatch.ValueString())
+	set("match", filter.Match.ValueString())
+
+	if !filter.Proxied.IsNull() && !filter.Proxied.IsUnknown() {
+		query.Set("proxied", strconv.FormatBool(filter.Proxied.ValueBool()))
+	}
+
+	for _, tag := range tags {
+		if strings.Contains(tag, ":") {
+			query.Add("tag", tag)
+		} else {
+			query.Add("tag.present", tag)
+		}
+	}
+
+	return query
+}
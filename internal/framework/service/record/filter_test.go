@@ -0,0 +1,76 @@
+package record
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordsQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filter   *RecordsFilterModel
+		tags     []string
+		expected url.Values
+	}{
+		"no filter": {
+			filter:   nil,
+			expected: url.Values{},
+		},
+		"empty filter": {
+			filter:   &RecordsFilterModel{},
+			expected: url.Values{},
+		},
+		"name": {
+			filter: &RecordsFilterModel{
+				Name:           types.StringValue("www.example.com."),
+				NameContains:   types.StringValue("ww"),
+				NameStartsWith: types.StringValue("www"),
+			},
+			expected: url.Values{
+				"name.exact":      {"www.example.com"},
+				"name.contains":   {"ww"},
+				"name.startswith": {"www"},
+			},
+		},
+		"all values match any": {
+			filter: &RecordsFilterModel{
+				Type:    types.StringValue("A"),
+				Content: types.StringValue("192.0.2.1"),
+				Proxied: types.BoolValue(false),
+				Comment: types.StringValue("owned by team-a"),
+				Match:   types.StringValue("any"),
+			},
+			expected: url.Values{
+				"type":          {"A"},
+				"content.exact": {"192.0.2.1"},
+				"proxied":       {"false"},
+				"comment.exact": {"owned by team-a"},
+				"match":         {"any"},
+			},
+		},
+		"tags": {
+			filter: &RecordsFilterModel{
+				TagMatch: types.StringValue("any"),
+			},
+			tags: []string{"owner:team-a", "production"},
+			expected: url.Values{
+				"tag":         {"owner:team-a"},
+				"tag.present": {"production"},
+				"tag_match":   {"any"},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.expected, recordsQuery(c.filter, c.tags))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package record
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type RecordsModel struct {
+	ZoneID  types.String        `tfsdk:"zone_id"`
+	ID      types.String        `tfsdk:"id"`
+	Filter  *RecordsFilterModel `tfsdk:"filter"`
+	Records []*RecordModel      `tfsdk:"records"`
+}
+
+type RecordsFilterModel struct {
+	Name           types.String `tfsdk:"name"`
+	NameContains   types.String `tfsdk:"name_contains"`
+	NameStartsWith types.String `tfsdk:"name_starts_with"`
+	Type           types.String `tfsdk:"type"`
+	Content        types.String `tfsdk:"content"`
+	Proxied        types.Bool   `tfsdk:"proxied"`
+	Comment        types.String `tfsdk:"comment"`
+	Tags           types.Set    `tfsdk:"tags"`
+	TagMatch       types.String `tfsdk:"tag_match"`
+	Match          types.String `tfsdk:"match"`
+}
+
+type RecordModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Content    types.String `tfsdk:"content"`
+	Proxied    types.Bool   `tfsdk:"proxied"`
+	Proxiable  types.Bool   `tfsdk:"proxiable"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	Comment    types.String `tfsdk:"comment"`
+	Tags       types.Set    `tfsdk:"tags"`
+	Locked     types.Bool   `tfsdk:"locked"`
+	CreatedOn  types.String `tfsdk:"created_on"`
+	ModifiedOn types.String `tfsdk:"modified_on"`
+}
@@ -0,0 +1,154 @@
+package record
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var matchModes = []string{"all", "any"}
+
+func (r *RecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Use this data source to look up [DNS records](https://developers.cloudflare.com/dns/manage-dns-records/)
+			in a zone by name, type, content, proxied status, comment and tags.
+		`),
+		Attributes: map[string]schema.Attribute{
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+			},
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of DNS records matching the filter.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the record.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The fully qualified name of the record.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the record.",
+						},
+						"content": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The content of the record.",
+						},
+						"proxied": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the record gets Cloudflare's origin protection.",
+						},
+						"proxiable": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the record can be proxied.",
+						},
+						"ttl": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The TTL of the record.",
+						},
+						"priority": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The priority of the record.",
+						},
+						"comment": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Comments or notes about the record.",
+						},
+						"tags": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Custom tags for the record.",
+						},
+						"locked": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the record is locked and can't be modified.",
+						},
+						"created_on": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The RFC3339 timestamp of when the record was created.",
+						},
+						"modified_on": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The RFC3339 timestamp of when the record was last modified.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "One or more values used to look up DNS records. Whether all or any of the values must match is controlled by `match`.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The fully qualified name of the records to lookup.",
+					},
+					"name_contains": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A substring of the name of the records to lookup.",
+					},
+					"name_starts_with": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A prefix of the name of the records to lookup.",
+					},
+					"type": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: fmt.Sprintf("The type of the records to lookup. %s", utils.RenderAvailableDocumentationValuesStringSlice(utils.DNSRecordTypes)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(utils.DNSRecordTypes...),
+						},
+					},
+					"content": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The content of the records to lookup.",
+					},
+					"proxied": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "The proxied status of the records to lookup.",
+					},
+					"comment": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The comment of the records to lookup.",
+					},
+					"tags": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Tags of the records to lookup. A tag in the form `name:value` must match exactly, a tag without a value matches any record with a tag of that name.",
+					},
+					"tag_match": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: fmt.Sprintf("Whether all or any of the `tags` must match. Defaults to `all`. %s", utils.RenderAvailableDocumentationValuesStringSlice(matchModes)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(matchModes...),
+						},
+					},
+					"match": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: fmt.Sprintf("Whether all or any of the filter values must match. Defaults to `all`. %s", utils.RenderAvailableDocumentationValuesStringSlice(matchModes)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(matchModes...),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,12 @@
+package secondary_dns_incoming
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type SecondaryDNSIncomingModel struct {
+	ZoneID             types.String `tfsdk:"zone_id"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	AutoRefreshSeconds types.Int64  `tfsdk:"auto_refresh_seconds"`
+	Peers              types.Set    `tfsdk:"peers"`
+	SOASerial          types.Int64  `tfsdk:"soa_serial"`
+}
@@ -0,0 +1,210 @@
+package secondary_dns_incoming
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecondaryDNSIncomingResource{}
+var _ resource.ResourceWithImportState = &SecondaryDNSIncomingResource{}
+
+func NewResource() resource.Resource {
+	return &SecondaryDNSIncomingResource{}
+}
+
+// SecondaryDNSIncomingResource defines the resource implementation.
+type SecondaryDNSIncomingResource struct {
+	client *cloudflare.API
+}
+
+// secondaryDNSIncoming is the API representation of an incoming zone.
+// cloudflare-go only exposes the deprecated endpoints that reference
+// primaries instead of peers, so the incoming endpoints are called directly.
+type secondaryDNSIncoming struct {
+	ID                 string   `json:"id,omitempty"`
+	Name               string   `json:"name"`
+	AutoRefreshSeconds int64    `json:"auto_refresh_seconds"`
+	Peers              []string `json:"peers"`
+	SOASerial          int64    `json:"soa_serial,omitempty"`
+}
+
+func (r *SecondaryDNSIncomingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secondary_dns_incoming"
+}
+
+func (r *SecondaryDNSIncomingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SecondaryDNSIncomingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecondaryDNSIncomingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	incoming, diags := r.writeIncoming(ctx, http.MethodPost, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildIncomingModel(data, incoming))...)
+}
+
+func (r *SecondaryDNSIncomingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SecondaryDNSIncomingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Raw(ctx, http.MethodGet, incomingURI(data.ZoneID.ValueString()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("secondary DNS incoming zone %s no longer exists", data.ZoneID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read secondary DNS incoming zone", err.Error())
+		return
+	}
+
+	var incoming secondaryDNSIncoming
+	if err := json.Unmarshal(res.Result, &incoming); err != nil {
+		resp.Diagnostics.AddError("failed to read secondary DNS incoming zone", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildIncomingModel(data, incoming))...)
+}
+
+func (r *SecondaryDNSIncomingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SecondaryDNSIncomingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	incoming, diags := r.writeIncoming(ctx, http.MethodPut, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildIncomingModel(data, incoming))...)
+}
+
+func (r *SecondaryDNSIncomingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecondaryDNSIncomingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Raw(ctx, http.MethodDelete, incomingURI(data.ZoneID.ValueString()), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete secondary DNS incoming zone", err.Error())
+		return
+	}
+}
+
+func (r *SecondaryDNSIncomingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (r *SecondaryDNSIncomingResource) writeIncoming(ctx context.Context, method string, data *SecondaryDNSIncomingModel) (secondaryDNSIncoming, diag.Diagnostics) {
+	var incoming secondaryDNSIncoming
+
+	var peers []string
+	diags := data.Peers.ElementsAs(ctx, &peers, false)
+	if diags.HasError() {
+		return incoming, diags
+	}
+
+	action := "create"
+	if method == http.MethodPut {
+		action = "update"
+	}
+
+	res, err := r.client.Raw(ctx, method, incomingURI(data.ZoneID.ValueString()), secondaryDNSIncoming{
+		Name:               data.Name.ValueString(),
+		AutoRefreshSeconds: data.AutoRefreshSeconds.ValueInt64(),
+		Peers:              peers,
+	}, nil)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to %s secondary DNS incoming zone", action), err.Error())
+		return incoming, diags
+	}
+
+	if err := json.Unmarshal(res.Result, &incoming); err != nil {
+		diags.AddError(fmt.Sprintf("failed to %s secondary DNS incoming zone", action), err.Error())
+	}
+
+	return incoming, diags
+}
+
+func incomingURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/secondary_dns/incoming", zoneID)
+}
+
+func buildIncomingModel(data *SecondaryDNSIncomingModel, incoming secondaryDNSIncoming) *SecondaryDNSIncomingModel {
+	peers := make([]attr.Value, 0, len(incoming.Peers))
+	for _, peer := range incoming.Peers {
+		peers = append(peers, types.StringValue(peer))
+	}
+
+	// Zone names are returned fully qualified; keep the configured form
+	// when it only differs by the trailing dot.
+	name := types.StringValue(incoming.Name)
+	if strings.TrimSuffix(data.Name.ValueString(), ".") == strings.TrimSuffix(incoming.Name, ".") {
+		name = data.Name
+	}
+
+	return &SecondaryDNSIncomingModel{
+		ZoneID:             data.ZoneID,
+		ID:                 data.ZoneID,
+		Name:               name,
+		AutoRefreshSeconds: types.Int64Value(incoming.AutoRefreshSeconds),
+		Peers:              types.SetValueMust(types.StringType, peers),
+		SOASerial:          types.Int64Value(incoming.SOASerial),
+	}
+}
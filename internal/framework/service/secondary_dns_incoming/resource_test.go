@@ -0,0 +1,76 @@
+package secondary_dns_incoming_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareSecondaryDNSIncoming_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneName := fmt.Sprintf("%s.cfapi.net", rnd)
+	resourceName := "cloudflare_secondary_dns_incoming." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareSecondaryDNSIncoming(rnd, accountID, zoneName, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "cloudflare_zone."+rnd, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", zoneName),
+					resource.TestCheckResourceAttr(resourceName, "auto_refresh_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "peers.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareSecondaryDNSIncoming(rnd, accountID, zoneName, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_refresh_seconds", "7200"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"soa_serial"},
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareSecondaryDNSIncoming(rnd, accountID, zoneName string, autoRefreshSeconds int) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_zone" "%[1]s" {
+    account_id = "%[2]s"
+    zone       = "%[3]s"
+    plan       = "enterprise"
+    type       = "secondary"
+  }
+
+  resource "cloudflare_secondary_dns_peer" "%[1]s" {
+    account_id = "%[2]s"
+    name       = "%[1]s"
+    ip         = "192.0.2.53"
+  }
+
+  resource "cloudflare_secondary_dns_incoming" "%[1]s" {
+    zone_id              = cloudflare_zone.%[1]s.id
+    name                 = "%[3]s"
+    auto_refresh_seconds = %[4]d
+    peers                = [cloudflare_secondary_dns_peer.%[1]s.id]
+  }`, rnd, accountID, zoneName, autoRefreshSeconds)
+}
@@ -0,0 +1,69 @@
+package secondary_dns_incoming
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *SecondaryDNSIncomingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare Secondary DNS incoming zone resource. The
+			zone is transferred from the configured peers, which act as the
+			primary nameservers, and served by Cloudflare.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the zone.",
+				Required:            true,
+			},
+			"auto_refresh_seconds": schema.Int64Attribute{
+				MarkdownDescription: "How often, in seconds, to check the primary for SOA serial changes. Ignored when the primary sends NOTIFY messages.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(86400),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"peers": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the `cloudflare_secondary_dns_peer` resources to transfer the zone from.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"soa_serial": schema.Int64Attribute{
+				MarkdownDescription: "The SOA serial of the last transferred version of the zone.",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,12 @@
+package secondary_dns_outgoing
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type SecondaryDNSOutgoingModel struct {
+	ZoneID    types.String `tfsdk:"zone_id"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Peers     types.Set    `tfsdk:"peers"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	SOASerial types.Int64  `tfsdk:"soa_serial"`
+}
@@ -0,0 +1,255 @@
+package secondary_dns_outgoing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecondaryDNSOutgoingResource{}
+var _ resource.ResourceWithImportState = &SecondaryDNSOutgoingResource{}
+
+func NewResource() resource.Resource {
+	return &SecondaryDNSOutgoingResource{}
+}
+
+// SecondaryDNSOutgoingResource defines the resource implementation.
+type SecondaryDNSOutgoingResource struct {
+	client *cloudflare.API
+}
+
+// secondaryDNSOutgoing is the API representation of an outgoing zone, which
+// isn't available in cloudflare-go.
+type secondaryDNSOutgoing struct {
+	ID        string   `json:"id,omitempty"`
+	Name      string   `json:"name"`
+	Peers     []string `json:"peers"`
+	SOASerial int64    `json:"soa_serial,omitempty"`
+}
+
+func (r *SecondaryDNSOutgoingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secondary_dns_outgoing"
+}
+
+func (r *SecondaryDNSOutgoingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SecondaryDNSOutgoingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecondaryDNSOutgoingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outgoing, diags := r.writeOutgoing(ctx, http.MethodPost, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Outgoing zone transfers are created disabled.
+	enabled := false
+	if data.Enabled.ValueBool() {
+		if err := r.setTransfersEnabled(ctx, data.ZoneID.ValueString(), true); err != nil {
+			resp.Diagnostics.AddError("failed to enable secondary DNS outgoing zone transfers", err.Error())
+		} else {
+			enabled = true
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildOutgoingModel(data, outgoing, enabled))...)
+}
+
+func (r *SecondaryDNSOutgoingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SecondaryDNSOutgoingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	res, err := r.client.Raw(ctx, http.MethodGet, outgoingURI(zoneID, ""), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("secondary DNS outgoing zone %s no longer exists", zoneID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read secondary DNS outgoing zone", err.Error())
+		return
+	}
+
+	var outgoing secondaryDNSOutgoing
+	if err := json.Unmarshal(res.Result, &outgoing); err != nil {
+		resp.Diagnostics.AddError("failed to read secondary DNS outgoing zone", err.Error())
+		return
+	}
+
+	res, err = r.client.Raw(ctx, http.MethodGet, outgoingURI(zoneID, "status"), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read secondary DNS outgoing zone transfer status", err.Error())
+		return
+	}
+
+	var status string
+	if err := json.Unmarshal(res.Result, &status); err != nil {
+		resp.Diagnostics.AddError("failed to read secondary DNS outgoing zone transfer status", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildOutgoingModel(data, outgoing, strings.EqualFold(status, "enabled")))...)
+}
+
+func (r *SecondaryDNSOutgoingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *SecondaryDNSOutgoingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outgoing, diags := r.writeOutgoing(ctx, http.MethodPut, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled := state.Enabled.ValueBool()
+	if !data.Enabled.Equal(state.Enabled) {
+		if err := r.setTransfersEnabled(ctx, data.ZoneID.ValueString(), data.Enabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("failed to update secondary DNS outgoing zone transfer status", err.Error())
+		} else {
+			enabled = data.Enabled.ValueBool()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildOutgoingModel(data, outgoing, enabled))...)
+}
+
+func (r *SecondaryDNSOutgoingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecondaryDNSOutgoingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Raw(ctx, http.MethodDelete, outgoingURI(data.ZoneID.ValueString(), ""), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete secondary DNS outgoing zone", err.Error())
+		return
+	}
+}
+
+func (r *SecondaryDNSOutgoingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (r *SecondaryDNSOutgoingResource) writeOutgoing(ctx context.Context, method string, data *SecondaryDNSOutgoingModel) (secondaryDNSOutgoing, diag.Diagnostics) {
+	var outgoing secondaryDNSOutgoing
+
+	var peers []string
+	diags := data.Peers.ElementsAs(ctx, &peers, false)
+	if diags.HasError() {
+		return outgoing, diags
+	}
+
+	action := "create"
+	if method == http.MethodPut {
+		action = "update"
+	}
+
+	res, err := r.client.Raw(ctx, method, outgoingURI(data.ZoneID.ValueString(), ""), secondaryDNSOutgoing{
+		Name:  data.Name.ValueString(),
+		Peers: peers,
+	}, nil)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to %s secondary DNS outgoing zone", action), err.Error())
+		return outgoing, diags
+	}
+
+	if err := json.Unmarshal(res.Result, &outgoing); err != nil {
+		diags.AddError(fmt.Sprintf("failed to %s secondary DNS outgoing zone", action), err.Error())
+	}
+
+	return outgoing, diags
+}
+
+func (r *SecondaryDNSOutgoingResource) setTransfersEnabled(ctx context.Context, zoneID string, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+
+	_, err := r.client.Raw(ctx, http.MethodPost, outgoingURI(zoneID, action), nil, nil)
+
+	return err
+}
+
+func outgoingURI(zoneID, suffix string) string {
+	uri := fmt.Sprintf("/zones/%s/secondary_dns/outgoing", zoneID)
+	if suffix != "" {
+		uri += "/" + suffix
+	}
+
+	return uri
+}
+
+func buildOutgoingModel(data *SecondaryDNSOutgoingModel, outgoing secondaryDNSOutgoing, enabled bool) *SecondaryDNSOutgoingModel {
+	peers := make([]attr.Value, 0, len(outgoing.Peers))
+	for _, peer := range outgoing.Peers {
+		peers = append(peers, types.StringValue(peer))
+	}
+
+	// Ignore the trailing dot the API adds to the zone name.
+	name := types.StringValue(outgoing.Name)
+	if strings.TrimSuffix(data.Name.ValueString(), ".") == strings.TrimSuffix(outgoing.Name, ".") {
+		name = data.Name
+	}
+
+	return &SecondaryDNSOutgoingModel{
+		ZoneID:    data.ZoneID,
+		ID:        data.ZoneID,
+		Name:      name,
+		Peers:     types.SetValueMust(types.StringType, peers),
+		Enabled:   types.BoolValue(enabled),
+		SOASerial: types.Int64Value(outgoing.SOASerial),
+	}
+}
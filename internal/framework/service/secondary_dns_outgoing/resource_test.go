@@ -0,0 +1,71 @@
+package secondary_dns_outgoing_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareSecondaryDNSOutgoing_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_secondary_dns_outgoing." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareSecondaryDNSOutgoing(rnd, accountID, zoneID, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "name", domain),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "peers.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareSecondaryDNSOutgoing(rnd, accountID, zoneID, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"soa_serial"},
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareSecondaryDNSOutgoing(rnd, accountID, zoneID, domain string, enabled bool) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_secondary_dns_peer" "%[1]s" {
+    account_id = "%[2]s"
+    name       = "%[1]s"
+    ip         = "192.0.2.53"
+  }
+
+  resource "cloudflare_secondary_dns_outgoing" "%[1]s" {
+    zone_id = "%[3]s"
+    name    = "%[4]s"
+    peers   = [cloudflare_secondary_dns_peer.%[1]s.id]
+    enabled = %[5]t
+  }`, rnd, accountID, zoneID, domain, enabled)
+}
@@ -0,0 +1,13 @@
+package secondary_dns_peer
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type SecondaryDNSPeerModel struct {
+	AccountID  types.String `tfsdk:"account_id"`
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	IP         types.String `tfsdk:"ip"`
+	Port       types.Int64  `tfsdk:"port"`
+	IXFREnable types.Bool   `tfsdk:"ixfr_enable"`
+	TSIGID     types.String `tfsdk:"tsig_id"`
+}
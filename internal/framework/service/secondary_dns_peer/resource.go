@@ -0,0 +1,224 @@
+package secondary_dns_peer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecondaryDNSPeerResource{}
+var _ resource.ResourceWithImportState = &SecondaryDNSPeerResource{}
+
+func NewResource() resource.Resource {
+	return &SecondaryDNSPeerResource{}
+}
+
+// SecondaryDNSPeerResource defines the resource implementation.
+type SecondaryDNSPeerResource struct {
+	client *cloudflare.API
+}
+
+// secondaryDNSPeer is the API representation of a peer. cloudflare-go only
+// exposes the deprecated primaries endpoints, so the peers endpoints are
+// called directly.
+type secondaryDNSPeer struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	IP         string `json:"ip,omitempty"`
+	Port       int64  `json:"port,omitempty"`
+	IXFREnable bool   `json:"ixfr_enable"`
+	TSIGID     string `json:"tsig_id,omitempty"`
+}
+
+func (r *SecondaryDNSPeerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secondary_dns_peer"
+}
+
+func (r *SecondaryDNSPeerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SecondaryDNSPeerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecondaryDNSPeerModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The create endpoint only accepts the name; the remaining
+	// configuration is applied with a follow up update.
+	uri := fmt.Sprintf("/accounts/%s/secondary_dns/peers", data.AccountID.ValueString())
+	res, err := r.client.Raw(ctx, http.MethodPost, uri, secondaryDNSPeer{Name: data.Name.ValueString()}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create secondary DNS peer", err.Error())
+		return
+	}
+
+	var peer secondaryDNSPeer
+	if err := json.Unmarshal(res.Result, &peer); err != nil {
+		resp.Diagnostics.AddError("failed to create secondary DNS peer", err.Error())
+		return
+	}
+	data.ID = types.StringValue(peer.ID)
+
+	peer, err = r.updatePeer(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create secondary DNS peer", err.Error())
+		// Keep the partially configured peer in state so it's updated, or
+		// destroyed, on the next apply instead of being orphaned.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildPeerModel(data.AccountID, peer))...)
+}
+
+func (r *SecondaryDNSPeerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SecondaryDNSPeerModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/secondary_dns/peers/%s", data.AccountID.ValueString(), data.ID.ValueString())
+	res, err := r.client.Raw(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("secondary DNS peer %s no longer exists", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read secondary DNS peer", err.Error())
+		return
+	}
+
+	var peer secondaryDNSPeer
+	if err := json.Unmarshal(res.Result, &peer); err != nil {
+		resp.Diagnostics.AddError("failed to read secondary DNS peer", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildPeerModel(data.AccountID, peer))...)
+}
+
+func (r *SecondaryDNSPeerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SecondaryDNSPeerModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peer, err := r.updatePeer(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update secondary DNS peer", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildPeerModel(data.AccountID, peer))...)
+}
+
+func (r *SecondaryDNSPeerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecondaryDNSPeerModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/secondary_dns/peers/%s", data.AccountID.ValueString(), data.ID.ValueString())
+	_, err := r.client.Raw(ctx, http.MethodDelete, uri, nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete secondary DNS peer", err.Error())
+		return
+	}
+}
+
+func (r *SecondaryDNSPeerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idparts := strings.Split(req.ID, "/")
+	if len(idparts) != 2 {
+		resp.Diagnostics.AddError("error splitting import ID", "invalid ID specified. Please specify the ID as \"<account_id>/<peer_id>\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("account_id"), idparts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), idparts[1],
+	)...)
+}
+
+func (r *SecondaryDNSPeerResource) updatePeer(ctx context.Context, data *SecondaryDNSPeerModel) (secondaryDNSPeer, error) {
+	var peer secondaryDNSPeer
+
+	uri := fmt.Sprintf("/accounts/%s/secondary_dns/peers/%s", data.AccountID.ValueString(), data.ID.ValueString())
+	res, err := r.client.Raw(ctx, http.MethodPut, uri, secondaryDNSPeer{
+		ID:         data.ID.ValueString(),
+		Name:       data.Name.ValueString(),
+		IP:         data.IP.ValueString(),
+		Port:       data.Port.ValueInt64(),
+		IXFREnable: data.IXFREnable.ValueBool(),
+		TSIGID:     data.TSIGID.ValueString(),
+	}, nil)
+	if err != nil {
+		return peer, err
+	}
+
+	err = json.Unmarshal(res.Result, &peer)
+
+	return peer, err
+}
+
+func buildPeerModel(accountID types.String, peer secondaryDNSPeer) *SecondaryDNSPeerModel {
+	model := &SecondaryDNSPeerModel{
+		AccountID:  accountID,
+		ID:         types.StringValue(peer.ID),
+		Name:       types.StringValue(peer.Name),
+		IP:         types.StringNull(),
+		Port:       types.Int64Value(peer.Port),
+		IXFREnable: types.BoolValue(peer.IXFREnable),
+		TSIGID:     types.StringNull(),
+	}
+
+	if peer.IP != "" {
+		model.IP = types.StringValue(peer.IP)
+	}
+
+	if peer.TSIGID != "" {
+		model.TSIGID = types.StringValue(peer.TSIGID)
+	}
+
+	return model
+}
@@ -0,0 +1,84 @@
+package secondary_dns_peer_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareSecondaryDNSPeer_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	resourceName := "cloudflare_secondary_dns_peer." + rnd
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareSecondaryDNSPeerMinimum(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rnd),
+					resource.TestCheckResourceAttr(resourceName, "ip", "192.0.2.53"),
+					resource.TestCheckResourceAttr(resourceName, "port", "53"),
+					resource.TestCheckResourceAttr(resourceName, "ixfr_enable", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "tsig_id"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareSecondaryDNSPeerWithTSIG(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "port", "5353"),
+					resource.TestCheckResourceAttr(resourceName, "ixfr_enable", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "tsig_id", "cloudflare_secondary_dns_tsig."+rnd, "id"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareSecondaryDNSPeerMinimum(rnd, accountID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_secondary_dns_peer" "%[1]s" {
+    account_id = "%[2]s"
+    name       = "%[1]s"
+    ip         = "192.0.2.53"
+  }`, rnd, accountID)
+}
+
+func testAccCheckCloudflareSecondaryDNSPeerWithTSIG(rnd, accountID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_secondary_dns_tsig" "%[1]s" {
+    account_id = "%[2]s"
+    name       = "%[1]s.tsig"
+    algorithm  = "hmac-sha256."
+    secret     = "c2VjcmV0LWZvci10ZXJyYWZvcm0tdGVzdHM="
+  }
+
+  resource "cloudflare_secondary_dns_peer" "%[1]s" {
+    account_id  = "%[2]s"
+    name        = "%[1]s"
+    ip          = "192.0.2.53"
+    port        = 5353
+    ixfr_enable = true
+    tsig_id     = cloudflare_secondary_dns_tsig.%[1]s.id
+  }`, rnd, accountID)
+}
@@ -0,0 +1,70 @@
+package secondary_dns_peer
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *SecondaryDNSPeerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare Secondary DNS Peer resource. Peers are the
+			nameservers Cloudflare transfers zones from (incoming) or sends
+			NOTIFY messages and zone transfers to (outgoing).
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.AccountIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the peer.",
+				Required:            true,
+			},
+			"ip": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 or IPv6 address of the peer. Required for peers Cloudflare transfers zones from.",
+				Optional:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The DNS port of the peer.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(53),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"ixfr_enable": schema.BoolAttribute{
+				MarkdownDescription: "Whether to use incremental zone transfers (IXFR) when transferring from the peer. Falls back to AXFR when the peer doesn't support it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tsig_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the `cloudflare_secondary_dns_tsig` used to authenticate transfers with the peer.",
+				Optional:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,11 @@
+package secondary_dns_tsig
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type SecondaryDNSTSIGModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Algorithm types.String `tfsdk:"algorithm"`
+	Secret    types.String `tfsdk:"secret"`
+}
@@ -0,0 +1,167 @@
+package secondary_dns_tsig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecondaryDNSTSIGResource{}
+var _ resource.ResourceWithImportState = &SecondaryDNSTSIGResource{}
+
+func NewResource() resource.Resource {
+	return &SecondaryDNSTSIGResource{}
+}
+
+// SecondaryDNSTSIGResource defines the resource implementation.
+type SecondaryDNSTSIGResource struct {
+	client *cloudflare.API
+}
+
+func (r *SecondaryDNSTSIGResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secondary_dns_tsig"
+}
+
+func (r *SecondaryDNSTSIGResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SecondaryDNSTSIGResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecondaryDNSTSIGModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tsig, err := r.client.CreateSecondaryDNSTSIG(ctx, data.AccountID.ValueString(), buildTSIG(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create secondary DNS TSIG", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildTSIGModel(data, tsig))...)
+}
+
+func (r *SecondaryDNSTSIGResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SecondaryDNSTSIGModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tsig, err := r.client.GetSecondaryDNSTSIG(ctx, data.AccountID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("secondary DNS TSIG %s no longer exists", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read secondary DNS TSIG", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildTSIGModel(data, tsig))...)
+}
+
+func (r *SecondaryDNSTSIGResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SecondaryDNSTSIGModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tsig, err := r.client.UpdateSecondaryDNSTSIG(ctx, data.AccountID.ValueString(), buildTSIG(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update secondary DNS TSIG", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildTSIGModel(data, tsig))...)
+}
+
+func (r *SecondaryDNSTSIGResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecondaryDNSTSIGModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSecondaryDNSTSIG(ctx, data.AccountID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete secondary DNS TSIG", err.Error())
+		return
+	}
+}
+
+func (r *SecondaryDNSTSIGResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idparts := strings.Split(req.ID, "/")
+	if len(idparts) != 2 {
+		resp.Diagnostics.AddError("error splitting import ID", "invalid ID specified. Please specify the ID as \"<account_id>/<tsig_id>\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("account_id"), idparts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), idparts[1],
+	)...)
+}
+
+func buildTSIG(data *SecondaryDNSTSIGModel) cloudflare.SecondaryDNSTSIG {
+	return cloudflare.SecondaryDNSTSIG{
+		ID:     data.ID.ValueString(),
+		Name:   data.Name.ValueString(),
+		Algo:   data.Algorithm.ValueString(),
+		Secret: data.Secret.ValueString(),
+	}
+}
+
+func buildTSIGModel(data *SecondaryDNSTSIGModel, tsig cloudflare.SecondaryDNSTSIG) *SecondaryDNSTSIGModel {
+	model := &SecondaryDNSTSIGModel{
+		AccountID: data.AccountID,
+		ID:        types.StringValue(tsig.ID),
+		Name:      types.StringValue(tsig.Name),
+		Algorithm: types.StringValue(tsig.Algo),
+		Secret:    data.Secret,
+	}
+
+	// The secret is only returned by some endpoints; keep the configured
+	// value when it's omitted so it doesn't show up as a change.
+	if tsig.Secret != "" {
+		model.Secret = types.StringValue(tsig.Secret)
+	}
+
+	return model
+}
@@ -0,0 +1,62 @@
+package secondary_dns_tsig_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareSecondaryDNSTSIG_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	resourceName := "cloudflare_secondary_dns_tsig." + rnd
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareSecondaryDNSTSIG(rnd, accountID, "hmac-sha256."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rnd+".tsig"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "hmac-sha256."),
+					resource.TestCheckResourceAttr(resourceName, "secret", "c2VjcmV0LWZvci10ZXJyYWZvcm0tdGVzdHM="),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareSecondaryDNSTSIG(rnd, accountID, "hmac-sha512."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "hmac-sha512."),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareSecondaryDNSTSIG(rnd, accountID, algorithm string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_secondary_dns_tsig" "%[1]s" {
+    account_id = "%[2]s"
+    name       = "%[1]s.tsig"
+    algorithm  = "%[3]s"
+    secret     = "c2VjcmV0LWZvci10ZXJyYWZvcm0tdGVzdHM="
+  }`, rnd, accountID, algorithm)
+}
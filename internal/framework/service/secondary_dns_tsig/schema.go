@@ -0,0 +1,69 @@
+package secondary_dns_tsig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var tsigAlgorithms = []string{
+	"hmac-md5.sig-alg.reg.int.",
+	"hmac-sha1.",
+	"hmac-sha224.",
+	"hmac-sha256.",
+	"hmac-sha384.",
+	"hmac-sha512.",
+}
+
+func (r *SecondaryDNSTSIGResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare Secondary DNS TSIG resource. TSIG keys
+			authenticate zone transfers between Cloudflare and the peers
+			of a secondary DNS zone and are referenced by
+			` + "`cloudflare_secondary_dns_peer`" + `.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.AccountIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the TSIG key. Must match the key name configured on the peer.",
+				Required:            true,
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The TSIG algorithm. %s", utils.RenderAvailableDocumentationValuesStringSlice(tsigAlgorithms)),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(tsigAlgorithms...),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The base64 encoded TSIG secret.",
+				Required:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
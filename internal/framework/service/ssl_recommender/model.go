@@ -0,0 +1,9 @@
+package ssl_recommender
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type SSLRecommenderModel struct {
+	ZoneID  types.String `tfsdk:"zone_id"`
+	ID      types.String `tfsdk:"id"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
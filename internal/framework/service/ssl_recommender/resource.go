@@ -0,0 +1,177 @@
+package ssl_recommender
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SSLRecommenderResource{}
+var _ resource.ResourceWithImportState = &SSLRecommenderResource{}
+
+func NewResource() resource.Resource {
+	return &SSLRecommenderResource{}
+}
+
+// SSLRecommenderResource defines the resource implementation.
+type SSLRecommenderResource struct {
+	client *cloudflare.API
+}
+
+// sslRecommenderSetting is the zone setting as returned by the API. Unlike
+// other zone settings it has an `enabled` field instead of a `value`.
+type sslRecommenderSetting struct {
+	ID      string `json:"id"`
+	Enabled bool   `json:"enabled"`
+}
+
+func (r *SSLRecommenderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_recommender"
+}
+
+func (r *SSLRecommenderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SSLRecommenderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SSLRecommenderModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := r.writeSetting(ctx, data.ZoneID.ValueString(), data.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update SSL/TLS Recommender", err.Error())
+		return
+	}
+
+	data.ID = data.ZoneID
+	data.Enabled = types.BoolValue(setting.Enabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSLRecommenderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SSLRecommenderModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := r.readSetting(ctx, data.ZoneID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("zone %s no longer exists", data.ZoneID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read SSL/TLS Recommender", err.Error())
+		return
+	}
+
+	data.ID = data.ZoneID
+	data.Enabled = types.BoolValue(setting.Enabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSLRecommenderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SSLRecommenderModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := r.writeSetting(ctx, data.ZoneID.ValueString(), data.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update SSL/TLS Recommender", err.Error())
+		return
+	}
+
+	data.Enabled = types.BoolValue(setting.Enabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSLRecommenderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SSLRecommenderModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.writeSetting(ctx, data.ZoneID.ValueString(), false); err != nil {
+		resp.Diagnostics.AddError("failed to disable SSL/TLS Recommender", err.Error())
+	}
+}
+
+func (r *SSLRecommenderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.ZoneIDSchemaKey), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.IDSchemaKey), req.ID)...)
+}
+
+func (r *SSLRecommenderResource) readSetting(ctx context.Context, zoneID string) (sslRecommenderSetting, error) {
+	var setting sslRecommenderSetting
+
+	res, err := r.client.Raw(ctx, http.MethodGet, settingURI(zoneID), nil, nil)
+	if err != nil {
+		return setting, err
+	}
+
+	err = json.Unmarshal(res.Result, &setting)
+
+	return setting, err
+}
+
+func (r *SSLRecommenderResource) writeSetting(ctx context.Context, zoneID string, enabled bool) (sslRecommenderSetting, error) {
+	var setting sslRecommenderSetting
+
+	params := map[string]interface{}{
+		"value": sslRecommenderSetting{ID: "ssl_recommender", Enabled: enabled},
+	}
+
+	res, err := r.client.Raw(ctx, http.MethodPatch, settingURI(zoneID), params, nil)
+	if err != nil {
+		return setting, err
+	}
+
+	err = json.Unmarshal(res.Result, &setting)
+
+	return setting, err
+}
+
+func settingURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/settings/ssl_recommender", zoneID)
+}
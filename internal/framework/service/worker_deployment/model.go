@@ -0,0 +1,18 @@
+package worker_deployment
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type WorkerDeploymentModel struct {
+	AccountID  types.String                    `tfsdk:"account_id"`
+	ID         types.String                    `tfsdk:"id"`
+	ScriptName types.String                    `tfsdk:"script_name"`
+	Message    types.String                    `tfsdk:"message"`
+	Source     types.String                    `tfsdk:"source"`
+	CreatedOn  types.String                    `tfsdk:"created_on"`
+	Version    []*WorkerDeploymentVersionModel `tfsdk:"version"`
+}
+
+type WorkerDeploymentVersionModel struct {
+	VersionID  types.String  `tfsdk:"version_id"`
+	Percentage types.Float64 `tfsdk:"percentage"`
+}
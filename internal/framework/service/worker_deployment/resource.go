@@ -0,0 +1,264 @@
+package worker_deployment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/flatteners"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	workerDeploymentStrategyPercentage = "percentage"
+	workerDeploymentMessageAnnotation  = "workers/message"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorkerDeploymentResource{}
+var _ resource.ResourceWithImportState = &WorkerDeploymentResource{}
+var _ resource.ResourceWithValidateConfig = &WorkerDeploymentResource{}
+
+func NewResource() resource.Resource {
+	return &WorkerDeploymentResource{}
+}
+
+// WorkerDeploymentResource defines the resource implementation.
+type WorkerDeploymentResource struct {
+	client *cloudflare.API
+}
+
+type workerDeploymentVersion struct {
+	VersionID  string  `json:"version_id"`
+	Percentage float64 `json:"percentage"`
+}
+
+type workerDeployment struct {
+	ID          string                    `json:"id,omitempty"`
+	Source      string                    `json:"source,omitempty"`
+	Strategy    string                    `json:"strategy"`
+	CreatedOn   string                    `json:"created_on,omitempty"`
+	Versions    []workerDeploymentVersion `json:"versions"`
+	Annotations map[string]string         `json:"annotations,omitempty"`
+}
+
+type workerDeploymentList struct {
+	Deployments []workerDeployment `json:"deployments"`
+}
+
+func (r *WorkerDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worker_deployment"
+}
+
+func (r *WorkerDeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkerDeploymentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var versions types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version"), &versions)...)
+
+	if resp.Diagnostics.HasError() || versions.IsNull() || versions.IsUnknown() {
+		return
+	}
+
+	var models []*WorkerDeploymentVersionModel
+	resp.Diagnostics.Append(versions.ElementsAs(ctx, &models, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	percentages := make([]float64, 0, len(models))
+	for _, v := range models {
+		// Percentages may be computed from other resources, defer validation
+		// until they are known.
+		if v.Percentage.IsUnknown() {
+			return
+		}
+		percentages = append(percentages, v.Percentage.ValueFloat64())
+	}
+
+	if err := validatePercentages(percentages); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "invalid Worker deployment versions", err.Error())
+	}
+}
+
+func (r *WorkerDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *WorkerDeploymentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.createDeployment(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create Worker deployment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildWorkerDeploymentModel(data, deployment))...)
+}
+
+func (r *WorkerDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *WorkerDeploymentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the latest deployment serves traffic so it is always the one
+	// reflected in state. Any deployment made outside of Terraform will show
+	// up as drift.
+	res, err := r.client.Raw(ctx, http.MethodGet, workerDeploymentsURI(data), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("removing Worker deployment for %q from state because the script is not present in the remote", data.ScriptName.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("failed reading Worker deployments", err.Error())
+		return
+	}
+
+	var list workerDeploymentList
+	if err := json.Unmarshal(res.Result, &list); err != nil {
+		resp.Diagnostics.AddError("failed to parse Worker deployments response", err.Error())
+		return
+	}
+
+	if len(list.Deployments) == 0 {
+		tflog.Warn(ctx, fmt.Sprintf("removing Worker deployment for %q from state because no deployments exist", data.ScriptName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildWorkerDeploymentModel(data, list.Deployments[0]))...)
+}
+
+func (r *WorkerDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *WorkerDeploymentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.createDeployment(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update Worker deployment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildWorkerDeploymentModel(data, deployment))...)
+}
+
+func (r *WorkerDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *WorkerDeploymentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Worker deployment %q cannot be deleted and will only be removed from state", data.ID.ValueString()))
+}
+
+func (r *WorkerDeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idparts := strings.Split(req.ID, "/")
+	if len(idparts) != 2 {
+		resp.Diagnostics.AddError("error importing Worker deployment", `invalid ID specified. Please specify the ID as "<account_id>/<script_name>"`)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("account_id"), idparts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("script_name"), idparts[1],
+	)...)
+}
+
+func (r *WorkerDeploymentResource) createDeployment(ctx context.Context, data *WorkerDeploymentModel) (workerDeployment, error) {
+	params := workerDeployment{
+		Strategy: workerDeploymentStrategyPercentage,
+		Versions: make([]workerDeploymentVersion, 0, len(data.Version)),
+	}
+
+	for _, v := range data.Version {
+		params.Versions = append(params.Versions, workerDeploymentVersion{
+			VersionID:  v.VersionID.ValueString(),
+			Percentage: v.Percentage.ValueFloat64(),
+		})
+	}
+
+	if data.Message.ValueString() != "" {
+		params.Annotations = map[string]string{workerDeploymentMessageAnnotation: data.Message.ValueString()}
+	}
+
+	res, err := r.client.Raw(ctx, http.MethodPost, workerDeploymentsURI(data), params, nil)
+	if err != nil {
+		return workerDeployment{}, err
+	}
+
+	var deployment workerDeployment
+	if err := json.Unmarshal(res.Result, &deployment); err != nil {
+		return workerDeployment{}, fmt.Errorf("failed to parse Worker deployment response: %w", err)
+	}
+
+	return deployment, nil
+}
+
+func workerDeploymentsURI(data *WorkerDeploymentModel) string {
+	return fmt.Sprintf("/accounts/%s/workers/scripts/%s/deployments", data.AccountID.ValueString(), data.ScriptName.ValueString())
+}
+
+func buildWorkerDeploymentModel(data *WorkerDeploymentModel, deployment workerDeployment) *WorkerDeploymentModel {
+	versions := make([]*WorkerDeploymentVersionModel, 0, len(deployment.Versions))
+	for _, v := range deployment.Versions {
+		versions = append(versions, &WorkerDeploymentVersionModel{
+			VersionID:  types.StringValue(v.VersionID),
+			Percentage: types.Float64Value(v.Percentage),
+		})
+	}
+
+	return &WorkerDeploymentModel{
+		AccountID:  data.AccountID,
+		ScriptName: data.ScriptName,
+		ID:         types.StringValue(deployment.ID),
+		Message:    flatteners.String(deployment.Annotations[workerDeploymentMessageAnnotation]),
+		Source:     types.StringValue(deployment.Source),
+		CreatedOn:  types.StringValue(deployment.CreatedOn),
+		Version:    versions,
+	}
+}
@@ -0,0 +1,114 @@
+package worker_deployment_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const moduleContent = `export default { fetch() { return new Response('Hello world'); }, };`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareWorkerDeployment_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	resourceName := "cloudflare_worker_deployment." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkerDeploymentConfig(rnd, accountID, 100, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "script_name", rnd),
+					resource.TestCheckResourceAttr(resourceName, "version.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "version.*", map[string]string{"percentage": "100"}),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccCloudflareWorkerDeploymentConfig(rnd, accountID, 90, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "version.*", map[string]string{"percentage": "90"}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "version.*", map[string]string{"percentage": "10"}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", accountID, rnd),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareWorkerDeployment_InvalidPercentages(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareWorkerDeploymentConfig(rnd, accountID, 60, 60),
+				ExpectError: regexp.MustCompile(`version percentages must add up to 100`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkerDeploymentConfig(rnd, accountID string, stablePercentage, canaryPercentage int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  content    = "%[3]s"
+  module     = true
+}
+
+resource "cloudflare_worker_version" "%[1]s_stable" {
+  account_id  = "%[2]s"
+  script_name = cloudflare_worker_script.%[1]s.name
+  content     = "%[3]s"
+  message     = "stable"
+}
+
+resource "cloudflare_worker_version" "%[1]s_canary" {
+  account_id  = "%[2]s"
+  script_name = cloudflare_worker_script.%[1]s.name
+  content     = "%[3]s"
+  message     = "canary"
+}
+
+resource "cloudflare_worker_deployment" "%[1]s" {
+  account_id  = "%[2]s"
+  script_name = cloudflare_worker_script.%[1]s.name
+
+  version {
+    version_id = cloudflare_worker_version.%[1]s_stable.id
+    percentage = %[4]d
+  }
+
+  version {
+    version_id = cloudflare_worker_version.%[1]s_canary.id
+    percentage = %[5]d
+  }
+}`, rnd, accountID, moduleContent, stablePercentage, canaryPercentage)
+}
@@ -0,0 +1,91 @@
+package worker_deployment
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *WorkerDeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Docf(`
+			Provides a Cloudflare Worker deployment resource. A deployment
+			splits the traffic of a Worker script between up to two
+			%s resources by percentage, allowing changes to be rolled out
+			gradually.
+
+			Every change creates a new deployment. Deployments cannot be
+			removed, destroying this resource leaves the latest deployment
+			serving traffic and only removes it from state.
+		`, "`cloudflare_worker_version`"),
+
+		Attributes: map[string]schema.Attribute{
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.AccountIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+			},
+			"script_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the Worker script to deploy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A human readable message describing the deployment.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"source": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The source that created the deployment.",
+			},
+			"created_on": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the deployment was created.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"version": schema.SetNestedBlock{
+				MarkdownDescription: "The versions to split traffic between. Percentages must add up to 100.",
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeBetween(1, 2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"version_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The identifier of the Worker version.",
+						},
+						"percentage": schema.Float64Attribute{
+							Required:            true,
+							MarkdownDescription: "The percentage of traffic to route to the version.",
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,21 @@
+package worker_deployment
+
+import (
+	"fmt"
+	"math"
+)
+
+// validatePercentages ensures the traffic split of a deployment covers all
+// requests.
+func validatePercentages(percentages []float64) error {
+	var total float64
+	for _, p := range percentages {
+		total += p
+	}
+
+	if math.Abs(total-100) > 0.01 {
+		return fmt.Errorf("version percentages must add up to 100, got %g", total)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package worker_deployment
+
+import (
+	"testing"
+)
+
+func TestValidatePercentages(t *testing.T) {
+	tests := map[string]struct {
+		percentages []float64
+		wantErr     bool
+	}{
+		"single version":       {percentages: []float64{100}},
+		"even split":           {percentages: []float64{50, 50}},
+		"uneven split":         {percentages: []float64{90.5, 9.5}},
+		"rounding tolerance":   {percentages: []float64{33.333, 66.667}},
+		"under 100":            {percentages: []float64{40, 50}, wantErr: true},
+		"over 100":             {percentages: []float64{60, 50}, wantErr: true},
+		"single version under": {percentages: []float64{99}, wantErr: true},
+		"empty":                {percentages: []float64{}, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validatePercentages(tc.percentages)
+			if tc.wantErr && err == nil {
+				t.Errorf("expected error for %v, got nil", tc.percentages)
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("unexpected error for %v: %s", tc.percentages, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package worker_script
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/flatteners"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &WorkerScriptsDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &WorkerScriptsDataSource{}
+}
+
+// WorkerScriptsDataSource defines the data source implementation.
+type WorkerScriptsDataSource struct {
+	client *cloudflare.API
+}
+
+func (r *WorkerScriptsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worker_scripts"
+}
+
+func (r *WorkerScriptsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkerScriptsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkerScriptsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameFilter *regexp.Regexp
+	if data.Filter != nil && data.Filter.Name.ValueString() != "" {
+		var err error
+		nameFilter, err = regexp.Compile(data.Filter.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter").AtName("name"), "invalid name filter", err.Error())
+			return
+		}
+	}
+
+	scripts, _, err := r.client.ListWorkers(ctx, cloudflare.AccountIdentifier(data.AccountID.ValueString()), cloudflare.ListWorkersParams{})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list Worker scripts", err.Error())
+		return
+	}
+
+	data.ID = data.AccountID
+	data.Scripts = make([]*WorkerScriptModel, 0, len(scripts.WorkerList))
+	for _, script := range scripts.WorkerList {
+		if nameFilter != nil && !nameFilter.MatchString(script.ID) {
+			continue
+		}
+
+		var placementMode string
+		if script.PlacementMode != nil {
+			placementMode = string(*script.PlacementMode)
+		}
+
+		data.Scripts = append(data.Scripts, &WorkerScriptModel{
+			Name:          types.StringValue(script.ID),
+			CreatedOn:     types.StringValue(script.CreatedOn.Format(time.RFC3339)),
+			ModifiedOn:    types.StringValue(script.ModifiedOn.Format(time.RFC3339)),
+			Logpush:       flatteners.Bool(script.Logpush),
+			PlacementMode: flatteners.String(placementMode),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
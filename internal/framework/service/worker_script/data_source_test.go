@@ -0,0 +1,53 @@
+package worker_script_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudflareWorkerScriptsDataSource_Filter(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := "data.cloudflare_worker_scripts." + rnd
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkerScriptsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "scripts.#", "1"),
+					resource.TestCheckResourceAttr(name, "scripts.0.name", rnd),
+					resource.TestCheckResourceAttrSet(name, "scripts.0.created_on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkerScriptsDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  content    = "export default { fetch() { return new Response('Hello world'); }, };"
+  module     = true
+}
+
+data "cloudflare_worker_scripts" "%[1]s" {
+  account_id = "%[2]s"
+
+  filter {
+    name = "^${cloudflare_worker_script.%[1]s.name}$"
+  }
+}`, rnd, accountID)
+}
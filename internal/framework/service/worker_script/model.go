@@ -0,0 +1,22 @@
+package worker_script
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type WorkerScriptsModel struct {
+	AccountID types.String              `tfsdk:"account_id"`
+	ID        types.String              `tfsdk:"id"`
+	Filter    *WorkerScriptsFilterModel `tfsdk:"filter"`
+	Scripts   []*WorkerScriptModel      `tfsdk:"scripts"`
+}
+
+type WorkerScriptsFilterModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type WorkerScriptModel struct {
+	Name          types.String `tfsdk:"name"`
+	CreatedOn     types.String `tfsdk:"created_on"`
+	ModifiedOn    types.String `tfsdk:"modified_on"`
+	Logpush       types.Bool   `tfsdk:"logpush"`
+	PlacementMode types.String `tfsdk:"placement_mode"`
+}
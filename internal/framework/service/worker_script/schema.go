@@ -0,0 +1,68 @@
+package worker_script
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (r *WorkerScriptsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Use this data source to look up [Worker scripts](https://developers.cloudflare.com/workers/)
+			in an account.
+		`),
+		Attributes: map[string]schema.Attribute{
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.AccountIDSchemaDescription,
+				Required:            true,
+			},
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+			},
+			"scripts": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of Worker scripts matching the filter.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the Worker script.",
+						},
+						"created_on": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the script was created.",
+						},
+						"modified_on": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the script was last modified.",
+						},
+						"logpush": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether Worker events are sent to Logpush.",
+						},
+						"placement_mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The placement mode of the Worker script.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "One or more values used to look up Worker scripts. If more than one value is given all values must match in order to be included.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A regular expression matching the name of the Worker scripts to lookup.",
+					},
+				},
+			},
+		},
+	}
+}
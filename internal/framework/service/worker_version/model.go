@@ -0,0 +1,25 @@
+package worker_version
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type WorkerVersionModel struct {
+	AccountID              types.String `tfsdk:"account_id"`
+	ID                     types.String `tfsdk:"id"`
+	ScriptName             types.String `tfsdk:"script_name"`
+	Content                types.String `tfsdk:"content"`
+	CompatibilityDate      types.String `tfsdk:"compatibility_date"`
+	CompatibilityFlags     types.Set    `tfsdk:"compatibility_flags"`
+	Message                types.String `tfsdk:"message"`
+	Tag                    types.String `tfsdk:"tag"`
+	Number                 types.Int64  `tfsdk:"number"`
+	CreatedOn              types.String `tfsdk:"created_on"`
+	PlainTextBinding       types.Set    `tfsdk:"plain_text_binding"`
+	SecretTextBinding      types.Set    `tfsdk:"secret_text_binding"`
+	KVNamespaceBinding     types.Set    `tfsdk:"kv_namespace_binding"`
+	WebAssemblyBinding     types.Set    `tfsdk:"webassembly_binding"`
+	ServiceBinding         types.Set    `tfsdk:"service_binding"`
+	R2BucketBinding        types.Set    `tfsdk:"r2_bucket_binding"`
+	AnalyticsEngineBinding types.Set    `tfsdk:"analytics_engine_binding"`
+	QueueBinding           types.Set    `tfsdk:"queue_binding"`
+	D1DatabaseBinding      types.Set    `tfsdk:"d1_database_binding"`
+}
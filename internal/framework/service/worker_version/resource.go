@@ -0,0 +1,171 @@
+package worker_version
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	workerVersionMainModule = "worker.mjs"
+
+	workerVersionMessageAnnotation = "workers/message"
+	workerVersionTagAnnotation     = "workers/tag"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorkerVersionResource{}
+
+func NewResource() resource.Resource {
+	return &WorkerVersionResource{}
+}
+
+// WorkerVersionResource defines the resource implementation.
+type WorkerVersionResource struct {
+	client *cloudflare.API
+}
+
+// workerVersion is the subset of the Worker version API response used by the
+// resource.
+type workerVersion struct {
+	ID       string `json:"id"`
+	Number   int64  `json:"number"`
+	Metadata struct {
+		CreatedOn string `json:"created_on"`
+	} `json:"metadata"`
+}
+
+type workerVersionMetadata struct {
+	MainModule         string                   `json:"main_module"`
+	Bindings           []map[string]interface{} `json:"bindings"`
+	CompatibilityDate  string                   `json:"compatibility_date,omitempty"`
+	CompatibilityFlags []string                 `json:"compatibility_flags,omitempty"`
+	Annotations        map[string]string        `json:"annotations,omitempty"`
+}
+
+func (r *WorkerVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worker_version"
+}
+
+func (r *WorkerVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkerVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *WorkerVersionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contentType, body, err := buildWorkerVersionBody(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to build Worker version upload", err.Error())
+		return
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", contentType)
+
+	res, err := r.client.Raw(ctx, http.MethodPost, workerVersionsURI(data), body, headers)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create Worker version", err.Error())
+		return
+	}
+
+	var version workerVersion
+	if err := json.Unmarshal(res.Result, &version); err != nil {
+		resp.Diagnostics.AddError("failed to parse Worker version response", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(version.ID)
+	data.Number = types.Int64Value(version.Number)
+	data.CreatedOn = types.StringValue(version.Metadata.CreatedOn)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkerVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *WorkerVersionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s/%s", workerVersionsURI(data), data.ID.ValueString()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("removing Worker version %q from state because it is not present in the remote", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("failed reading Worker version", err.Error())
+		return
+	}
+
+	var version workerVersion
+	if err := json.Unmarshal(res.Result, &version); err != nil {
+		resp.Diagnostics.AddError("failed to parse Worker version response", err.Error())
+		return
+	}
+
+	data.Number = types.Int64Value(version.Number)
+	data.CreatedOn = types.StringValue(version.Metadata.CreatedOn)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkerVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *WorkerVersionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddError("failed to update Worker version", "Worker versions are immutable and must be replaced")
+}
+
+func (r *WorkerVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *WorkerVersionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Worker version %q cannot be deleted and will only be removed from state", data.ID.ValueString()))
+}
+
+func workerVersionsURI(data *WorkerVersionModel) string {
+	return fmt.Sprintf("/accounts/%s/workers/scripts/%s/versions", data.AccountID.ValueString(), data.ScriptName.ValueString())
+}
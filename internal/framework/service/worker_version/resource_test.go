@@ -0,0 +1,74 @@
+package worker_version_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const moduleContent = `export default { fetch() { return new Response('Hello world'); }, };`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareWorkerVersion_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	resourceName := "cloudflare_worker_version." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkerVersionConfig(rnd, accountID, "first version"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "script_name", rnd),
+					resource.TestCheckResourceAttr(resourceName, "message", "first version"),
+					resource.TestCheckResourceAttr(resourceName, "plain_text_binding.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "number"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_on"),
+				),
+			},
+			{
+				Config: testAccCloudflareWorkerVersionConfig(rnd, accountID, "second version"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "message", "second version"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkerVersionConfig(rnd, accountID, message string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  content    = "%[3]s"
+  module     = true
+}
+
+resource "cloudflare_worker_version" "%[1]s" {
+  account_id         = "%[2]s"
+  script_name        = cloudflare_worker_script.%[1]s.name
+  content            = "%[3]s"
+  compatibility_date = "2024-01-01"
+  message            = "%[4]s"
+
+  plain_text_binding {
+    name = "GREETING"
+    text = "hello"
+  }
+}`, rnd, accountID, moduleContent, message)
+}
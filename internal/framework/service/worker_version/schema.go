@@ -0,0 +1,238 @@
+package worker_version
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *WorkerVersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	bindingName := schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The global variable for the binding in your Worker code.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Docf(`
+			Provides a Cloudflare Worker version resource. A version is an
+			immutable upload of a Worker's code and bindings that does not
+			receive any traffic until it is referenced by a %s.
+			Versions cannot be deleted, destroying this resource only removes
+			it from state.
+
+			The Worker script must already exist, for instance by managing it
+			with %s, and the content is uploaded as an ES module.
+		`, "`cloudflare_worker_deployment`", "`cloudflare_worker_script`"),
+
+		Attributes: map[string]schema.Attribute{
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.AccountIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"script_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the Worker script to upload the version for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The script content.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compatibility_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date to use for the compatibility flag.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compatibility_flags": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Compatibility flags used for the Worker version.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A human readable message describing the version.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A tag used to identify the version, such as a commit SHA.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(25),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The sequential number assigned to the version by Cloudflare.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the version was uploaded.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"plain_text_binding": schema.SetNestedBlock{
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": bindingName,
+						"text": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The plain text you want to store.",
+						},
+					},
+				},
+			},
+			"secret_text_binding": schema.SetNestedBlock{
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": bindingName,
+						"text": schema.StringAttribute{
+							Required:            true,
+							Sensitive:           true,
+							MarkdownDescription: "The secret text you want to store.",
+						},
+					},
+				},
+			},
+			"kv_namespace_binding": schema.SetNestedBlock{
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": bindingName,
+						"namespace_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "ID of the KV namespace you want to use.",
+						},
+					},
+				},
+			},
+			"webassembly_binding": schema.SetNestedBlock{
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": bindingName,
+						"module": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The base64 encoded wasm module you want to store.",
+						},
+					},
+				},
+			},
+			"service_binding": schema.SetNestedBlock{
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": bindingName,
+						"service": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the Worker to bind to.",
+						},
+						"environment": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The name of the Worker environment to bind to.",
+						},
+					},
+				},
+			},
+			"r2_bucket_binding": schema.SetNestedBlock{
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": bindingName,
+						"bucket_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the Bucket to bind to.",
+						},
+					},
+				},
+			},
+			"analytics_engine_binding": schema.SetNestedBlock{
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": bindingName,
+						"dataset": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the Analytics Engine dataset to write to.",
+						},
+					},
+				},
+			},
+			"queue_binding": schema.SetNestedBlock{
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"binding": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the global variable for the binding in your Worker code.",
+						},
+						"queue": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the queue you want to use.",
+						},
+					},
+				},
+			},
+			"d1_database_binding": schema.SetNestedBlock{
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": bindingName,
+						"database_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Database ID of D1 database to use.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
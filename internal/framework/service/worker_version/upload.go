@@ -0,0 +1,183 @@
+package worker_version
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"sort"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/expanders"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workerBindingBlocks returns the attributes of each binding block as
+// strings, the shape `utils.ParseWorkerBindings` expects.
+func workerBindingBlocks(set types.Set) []interface{} {
+	blocks := make([]interface{}, 0, len(set.Elements()))
+	for _, element := range set.Elements() {
+		block := make(map[string]interface{})
+		for name, value := range element.(types.Object).Attributes() {
+			block[name] = value.(types.String).ValueString()
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+// buildWorkerBindings converts the binding blocks into the `cloudflare-go`
+// binding types with the parser `cloudflare_worker_script` uses.
+func buildWorkerBindings(data *WorkerVersionModel) map[string]cloudflare.WorkerBinding {
+	blocks := map[string]types.Set{
+		"plain_text_binding":       data.PlainTextBinding,
+		"secret_text_binding":      data.SecretTextBinding,
+		"kv_namespace_binding":     data.KVNamespaceBinding,
+		"webassembly_binding":      data.WebAssemblyBinding,
+		"service_binding":          data.ServiceBinding,
+		"r2_bucket_binding":        data.R2BucketBinding,
+		"analytics_engine_binding": data.AnalyticsEngineBinding,
+		"queue_binding":            data.QueueBinding,
+		"d1_database_binding":      data.D1DatabaseBinding,
+	}
+
+	bindings := make(map[string]cloudflare.WorkerBinding)
+	utils.ParseWorkerBindings(func(name string) []interface{} {
+		return workerBindingBlocks(blocks[name])
+	}, bindings)
+
+	return bindings
+}
+
+// workerBindingMetadata returns the upload metadata for a single binding.
+func workerBindingMetadata(name string, binding cloudflare.WorkerBinding) (map[string]interface{}, error) {
+	meta := map[string]interface{}{
+		"name": name,
+		"type": binding.Type().String(),
+	}
+
+	switch b := binding.(type) {
+	case cloudflare.WorkerKvNamespaceBinding:
+		meta["namespace_id"] = b.NamespaceID
+	case cloudflare.WorkerPlainTextBinding:
+		meta["text"] = b.Text
+	case cloudflare.WorkerSecretTextBinding:
+		meta["text"] = b.Text
+	case cloudflare.WorkerWebAssemblyBinding:
+		// The module is uploaded in its own part named after the binding.
+		meta["part"] = name
+	case cloudflare.WorkerServiceBinding:
+		meta["service"] = b.Service
+		if cloudflare.String(b.Environment) != "" {
+			meta["environment"] = cloudflare.String(b.Environment)
+		}
+	case cloudflare.WorkerR2BucketBinding:
+		meta["bucket_name"] = b.BucketName
+	case cloudflare.WorkerAnalyticsEngineBinding:
+		meta["dataset"] = b.Dataset
+	case cloudflare.WorkerQueueBinding:
+		meta["queue_name"] = b.Queue
+	case cloudflare.WorkerD1DatabaseBinding:
+		meta["id"] = b.DatabaseID
+	default:
+		return nil, fmt.Errorf("binding %q has unsupported type %q", name, binding.Type())
+	}
+
+	return meta, nil
+}
+
+// buildWorkerVersionBody returns the content type and multipart body for
+// uploading a Worker version.
+func buildWorkerVersionBody(ctx context.Context, data *WorkerVersionModel) (string, []byte, error) {
+	bindings := buildWorkerBindings(data)
+
+	names := make([]string, 0, len(bindings))
+	for name := range bindings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	meta := workerVersionMetadata{
+		MainModule:         workerVersionMainModule,
+		Bindings:           make([]map[string]interface{}, 0, len(bindings)),
+		CompatibilityDate:  data.CompatibilityDate.ValueString(),
+		CompatibilityFlags: expanders.StringSet(ctx, data.CompatibilityFlags),
+	}
+
+	for _, name := range names {
+		bindingMeta, err := workerBindingMetadata(name, bindings[name])
+		if err != nil {
+			return "", nil, err
+		}
+		meta.Bindings = append(meta.Bindings, bindingMeta)
+	}
+
+	annotations := make(map[string]string)
+	if data.Message.ValueString() != "" {
+		annotations[workerVersionMessageAnnotation] = data.Message.ValueString()
+	}
+	if data.Tag.ValueString() != "" {
+		annotations[workerVersionTagAnnotation] = data.Tag.ValueString()
+	}
+	if len(annotations) > 0 {
+		meta.Annotations = annotations
+	}
+
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	mpw := multipart.NewWriter(&buf)
+
+	hdr := textproto.MIMEHeader{}
+	hdr.Set("Content-Disposition", `form-data; name="metadata"`)
+	hdr.Set("Content-Type", "application/json")
+	pw, err := mpw.CreatePart(hdr)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := pw.Write(metaJSON); err != nil {
+		return "", nil, err
+	}
+
+	hdr = textproto.MIMEHeader{}
+	hdr.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%[1]s"; filename="%[1]s"`, workerVersionMainModule))
+	hdr.Set("Content-Type", "application/javascript+module")
+	pw, err = mpw.CreatePart(hdr)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := pw.Write([]byte(data.Content.ValueString())); err != nil {
+		return "", nil, err
+	}
+
+	for _, name := range names {
+		wasm, ok := bindings[name].(cloudflare.WorkerWebAssemblyBinding)
+		if !ok {
+			continue
+		}
+
+		hdr = textproto.MIMEHeader{}
+		hdr.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, name))
+		hdr.Set("Content-Type", "application/wasm")
+		pw, err = mpw.CreatePart(hdr)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, err := io.Copy(pw, wasm.Module); err != nil {
+			return "", nil, fmt.Errorf("failed to read module of binding %q: %w", name, err)
+		}
+	}
+
+	if err := mpw.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return mpw.FormDataContentType(), buf.Bytes(), nil
+}
@@ -0,0 +1,182 @@
+package worker_version
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWorkerBindingMetadata(t *testing.T) {
+	tests := map[string]struct {
+		binding cloudflare.WorkerBinding
+		want    map[string]interface{}
+	}{
+		"kv namespace": {
+			binding: cloudflare.WorkerKvNamespaceBinding{NamespaceID: "abc"},
+			want:    map[string]interface{}{"name": "b", "type": "kv_namespace", "namespace_id": "abc"},
+		},
+		"plain text": {
+			binding: cloudflare.WorkerPlainTextBinding{Text: "hello"},
+			want:    map[string]interface{}{"name": "b", "type": "plain_text", "text": "hello"},
+		},
+		"secret text": {
+			binding: cloudflare.WorkerSecretTextBinding{Text: "shh"},
+			want:    map[string]interface{}{"name": "b", "type": "secret_text", "text": "shh"},
+		},
+		"service without environment": {
+			binding: cloudflare.WorkerServiceBinding{Service: "other"},
+			want:    map[string]interface{}{"name": "b", "type": "service", "service": "other"},
+		},
+		"service with environment": {
+			binding: cloudflare.WorkerServiceBinding{Service: "other", Environment: cloudflare.StringPtr("staging")},
+			want:    map[string]interface{}{"name": "b", "type": "service", "service": "other", "environment": "staging"},
+		},
+		"r2 bucket": {
+			binding: cloudflare.WorkerR2BucketBinding{BucketName: "bucket"},
+			want:    map[string]interface{}{"name": "b", "type": "r2_bucket", "bucket_name": "bucket"},
+		},
+		"analytics engine": {
+			binding: cloudflare.WorkerAnalyticsEngineBinding{Dataset: "dataset"},
+			want:    map[string]interface{}{"name": "b", "type": "analytics_engine", "dataset": "dataset"},
+		},
+		"queue": {
+			binding: cloudflare.WorkerQueueBinding{Binding: "b", Queue: "queue"},
+			want:    map[string]interface{}{"name": "b", "type": "queue", "queue_name": "queue"},
+		},
+		"d1": {
+			binding: cloudflare.WorkerD1DatabaseBinding{DatabaseID: "db"},
+			want:    map[string]interface{}{"name": "b", "type": "d1", "id": "db"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := workerBindingMetadata("b", tc.binding)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// testBindingBlocks returns a set of binding blocks with string attributes.
+func testBindingBlocks(blocks ...map[string]string) types.Set {
+	var objectType types.ObjectType
+	elements := make([]attr.Value, 0, len(blocks))
+	for _, block := range blocks {
+		attrTypes := make(map[string]attr.Type, len(block))
+		attrs := make(map[string]attr.Value, len(block))
+		for name, value := range block {
+			attrTypes[name] = types.StringType
+			attrs[name] = types.StringValue(value)
+		}
+		objectType = types.ObjectType{AttrTypes: attrTypes}
+		elements = append(elements, types.ObjectValueMust(attrTypes, attrs))
+	}
+
+	return types.SetValueMust(objectType, elements)
+}
+
+func TestBuildWorkerBindings(t *testing.T) {
+	bindings := buildWorkerBindings(&WorkerVersionModel{
+		ServiceBinding: testBindingBlocks(map[string]string{"name": "OTHER", "service": "other", "environment": ""}),
+		QueueBinding:   testBindingBlocks(map[string]string{"binding": "QUEUE", "queue": "queue"}),
+	})
+
+	want := map[string]cloudflare.WorkerBinding{
+		"OTHER": cloudflare.WorkerServiceBinding{Service: "other", Environment: cloudflare.StringPtr("")},
+		"QUEUE": cloudflare.WorkerQueueBinding{Binding: "QUEUE", Queue: "queue"},
+	}
+	if !reflect.DeepEqual(bindings, want) {
+		t.Errorf("got %v, want %v", bindings, want)
+	}
+}
+
+func TestBuildWorkerVersionBody(t *testing.T) {
+	ctx := context.Background()
+	data := &WorkerVersionModel{
+		Content:            types.StringValue("export default { fetch() { return new Response('ok') } }"),
+		CompatibilityDate:  types.StringValue("2024-01-01"),
+		CompatibilityFlags: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("nodejs_compat")}),
+		Message:            types.StringValue("initial version"),
+		Tag:                types.StringNull(),
+		PlainTextBinding: testBindingBlocks(
+			map[string]string{"name": "ZED", "text": "z"},
+			map[string]string{"name": "ALPHA", "text": "a"},
+		),
+		WebAssemblyBinding: testBindingBlocks(
+			map[string]string{"name": "WASM", "module": base64.StdEncoding.EncodeToString([]byte("\x00asm"))},
+		),
+	}
+
+	contentType, body, err := buildWorkerVersionBody(ctx, data)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("failed to parse content type: %s", err)
+	}
+	if mediaType != "multipart/form-data" {
+		t.Fatalf("expected multipart/form-data, got %q", mediaType)
+	}
+
+	parts := make(map[string][]byte)
+	mr := multipart.NewReader(strings.NewReader(string(body)), params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to read part: %s", err)
+		}
+		b, _ := io.ReadAll(p)
+		parts[p.FormName()] = b
+	}
+
+	if got := string(parts[workerVersionMainModule]); got != data.Content.ValueString() {
+		t.Errorf("unexpected module content %q", got)
+	}
+
+	var meta workerVersionMetadata
+	if err := json.Unmarshal(parts["metadata"], &meta); err != nil {
+		t.Fatalf("failed to parse metadata: %s", err)
+	}
+
+	if meta.MainModule != workerVersionMainModule {
+		t.Errorf("expected main module %q, got %q", workerVersionMainModule, meta.MainModule)
+	}
+	if meta.CompatibilityDate != "2024-01-01" {
+		t.Errorf("unexpected compatibility date %q", meta.CompatibilityDate)
+	}
+	if !reflect.DeepEqual(meta.CompatibilityFlags, []string{"nodejs_compat"}) {
+		t.Errorf("unexpected compatibility flags %v", meta.CompatibilityFlags)
+	}
+	if !reflect.DeepEqual(meta.Annotations, map[string]string{workerVersionMessageAnnotation: "initial version"}) {
+		t.Errorf("unexpected annotations %v", meta.Annotations)
+	}
+	if len(meta.Bindings) != 3 || meta.Bindings[0]["name"] != "ALPHA" || meta.Bindings[1]["name"] != "WASM" || meta.Bindings[2]["name"] != "ZED" {
+		t.Errorf("expected bindings sorted by name, got %v", meta.Bindings)
+	}
+	if meta.Bindings[1]["type"] != "wasm_module" || meta.Bindings[1]["part"] != "WASM" {
+		t.Errorf("unexpected webassembly binding %v", meta.Bindings[1])
+	}
+	if got := string(parts["WASM"]); got != "\x00asm" {
+		t.Errorf("unexpected webassembly module %q", got)
+	}
+}
@@ -0,0 +1,86 @@
+package workers_kv_namespace
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &WorkersKVNamespacesDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &WorkersKVNamespacesDataSource{}
+}
+
+// WorkersKVNamespacesDataSource defines the data source implementation.
+type WorkersKVNamespacesDataSource struct {
+	client *cloudflare.API
+}
+
+func (r *WorkersKVNamespacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workers_kv_namespaces"
+}
+
+func (r *WorkersKVNamespacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkersKVNamespacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkersKVNamespacesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter *regexp.Regexp
+	if data.Filter != nil && data.Filter.Title.ValueString() != "" {
+		var err error
+		filter, err = regexp.Compile(data.Filter.Title.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filter").AtName("title"), "invalid title filter", err.Error())
+			return
+		}
+	}
+
+	namespaces, _, err := r.client.ListWorkersKVNamespaces(ctx, cloudflare.AccountIdentifier(data.AccountID.ValueString()), cloudflare.ListWorkersKVNamespacesParams{})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list Workers KV namespaces", err.Error())
+		return
+	}
+
+	data.ID = data.AccountID
+	data.Namespaces = make([]*WorkersKVNamespaceModel, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		if filter != nil && !filter.MatchString(namespace.Title) {
+			continue
+		}
+
+		data.Namespaces = append(data.Namespaces, &WorkersKVNamespaceModel{
+			ID:    types.StringValue(namespace.ID),
+			Title: types.StringValue(namespace.Title),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+package workers_kv_namespace_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudflareWorkersKVNamespacesDataSource_Filter(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := "data.cloudflare_workers_kv_namespaces." + rnd
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Account(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkersKVNamespacesDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "namespaces.#", "1"),
+					resource.TestCheckResourceAttr(name, "namespaces.0.title", rnd),
+					resource.TestCheckResourceAttrSet(name, "namespaces.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkersKVNamespacesDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_workers_kv_namespace" "%[1]s" {
+  account_id = "%[2]s"
+  title      = "%[1]s"
+}
+
+data "cloudflare_workers_kv_namespaces" "%[1]s" {
+  account_id = "%[2]s"
+
+  filter {
+    title = "^${cloudflare_workers_kv_namespace.%[1]s.title}$"
+  }
+}`, rnd, accountID)
+}
@@ -0,0 +1,19 @@
+package workers_kv_namespace
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type WorkersKVNamespacesModel struct {
+	AccountID  types.String                    `tfsdk:"account_id"`
+	ID         types.String                    `tfsdk:"id"`
+	Filter     *WorkersKVNamespacesFilterModel `tfsdk:"filter"`
+	Namespaces []*WorkersKVNamespaceModel      `tfsdk:"namespaces"`
+}
+
+type WorkersKVNamespacesFilterModel struct {
+	Title types.String `tfsdk:"title"`
+}
+
+type WorkersKVNamespaceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Title types.String `tfsdk:"title"`
+}
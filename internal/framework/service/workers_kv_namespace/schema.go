@@ -0,0 +1,56 @@
+package workers_kv_namespace
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (r *WorkersKVNamespacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Use this data source to look up [Workers KV namespaces](https://developers.cloudflare.com/kv/)
+			in an account.
+		`),
+		Attributes: map[string]schema.Attribute{
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.AccountIDSchemaDescription,
+				Required:            true,
+			},
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+			},
+			"namespaces": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of Workers KV namespaces matching the filter.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the Workers KV namespace.",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The title of the Workers KV namespace.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "One or more values used to look up Workers KV namespaces. If more than one value is given all values must match in order to be included.",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A regular expression matching the title of the Workers KV namespaces to lookup.",
+					},
+				},
+			},
+		},
+	}
+}
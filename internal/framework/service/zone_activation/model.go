@@ -0,0 +1,13 @@
+package zone_activation
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ZoneActivationModel struct {
+	ZoneID            types.String `tfsdk:"zone_id"`
+	ID                types.String `tfsdk:"id"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	WaitForActivation types.Bool   `tfsdk:"wait_for_activation"`
+	Timeout           types.String `tfsdk:"timeout"`
+	Status            types.String `tfsdk:"status"`
+	NameServers       types.List   `tfsdk:"name_servers"`
+}
@@ -0,0 +1,204 @@
+package zone_activation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneActivationResource{}
+var _ resource.ResourceWithImportState = &ZoneActivationResource{}
+var _ resource.ResourceWithValidateConfig = &ZoneActivationResource{}
+
+func NewResource() resource.Resource {
+	return &ZoneActivationResource{}
+}
+
+// ZoneActivationResource defines the resource implementation.
+type ZoneActivationResource struct {
+	client *cloudflare.API
+}
+
+func (r *ZoneActivationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_activation"
+}
+
+func (r *ZoneActivationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneActivationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *ZoneActivationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Timeout.IsNull() || data.Timeout.IsUnknown() {
+		return
+	}
+
+	if timeout, err := time.ParseDuration(data.Timeout.ValueString()); err != nil || timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"invalid timeout",
+			fmt.Sprintf("%q is not a positive duration, use a value such as \"30m\" or \"2h\".", data.Timeout.ValueString()),
+		)
+	}
+}
+
+func (r *ZoneActivationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ZoneActivationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+
+	var zone cloudflare.Zone
+	var err error
+	if data.WaitForActivation.ValueBool() {
+		timeout, _ := time.ParseDuration(data.Timeout.ValueString())
+		zone, err = utils.WaitForActiveZone(ctx, r.client, zoneID, timeout)
+	} else {
+		zone, err = r.checkZoneActivation(ctx, zoneID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to activate zone", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(zoneID)
+	resp.Diagnostics.Append(setZoneStatus(ctx, data, zone)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneActivationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ZoneActivationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.client.ZoneDetails(ctx, data.ZoneID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("zone %s no longer exists", data.ZoneID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read zone", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setZoneStatus(ctx, data, zone)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneActivationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ZoneActivationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only `wait_for_activation` and `timeout` can change in place, so a
+	// zone that is already active needs no further checks.
+	if data.WaitForActivation.ValueBool() && state.Status.ValueString() != "active" {
+		timeout, _ := time.ParseDuration(data.Timeout.ValueString())
+		zone, err := utils.WaitForActiveZone(ctx, r.client, data.ZoneID.ValueString(), timeout)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to activate zone", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(setZoneStatus(ctx, data, zone)...)
+	} else {
+		data.Status = state.Status
+		data.NameServers = state.NameServers
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneActivationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ZoneActivationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("removing zone activation for %s from state, the zone is left unchanged", data.ZoneID.ValueString()))
+}
+
+func (r *ZoneActivationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.ZoneIDSchemaKey), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.IDSchemaKey), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_activation"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), "30m")...)
+}
+
+// checkZoneActivation triggers a single activation check for zones which
+// aren't active yet.
+func (r *ZoneActivationResource) checkZoneActivation(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+	zone, err := r.client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return zone, fmt.Errorf("error finding zone %q: %w", zoneID, err)
+	}
+
+	if zone.Status == "active" {
+		return zone, nil
+	}
+
+	if _, err := r.client.ZoneActivationCheck(ctx, zoneID); err != nil {
+		// The check is rate limited so a recent check by another client
+		// isn't an error for us.
+		tflog.Warn(ctx, fmt.Sprintf("failed to trigger activation check for zone %s: %s", zoneID, err))
+	}
+
+	return zone, nil
+}
+
+func setZoneStatus(ctx context.Context, data *ZoneActivationModel, zone cloudflare.Zone) diag.Diagnostics {
+	nameServers, diags := types.ListValueFrom(ctx, types.StringType, zone.NameServers)
+
+	data.Status = types.StringValue(zone.Status)
+	data.NameServers = nameServers
+
+	return diags
+}
@@ -0,0 +1,75 @@
+package zone_activation
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *ZoneActivationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare resource to trigger the activation check of
+			a zone and wait for it to become active. This allows the zone to be
+			created, the name servers to be delegated at the registrar and
+			the zone to be activated in a single run.
+
+			Destroying the resource has no effect on the zone.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will trigger the activation check again, e.g. the name servers set at the registrar. **Modifying this attribute will force creation of a new resource.**",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_activation": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the zone to reach status `active`. When `false`, the activation check is only triggered once. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the zone to become active, as a duration such as `30m` or `2h`. Defaults to `30m`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("30m"),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the zone.",
+				Computed:            true,
+			},
+			"name_servers": schema.ListAttribute{
+				MarkdownDescription: "The Cloudflare-assigned name servers to set at the registrar.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package zone_dns_settings
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ZoneDNSSettingsModel struct {
+	ZoneID             types.String                     `tfsdk:"zone_id"`
+	ID                 types.String                     `tfsdk:"id"`
+	FoundationDNS      types.Bool                       `tfsdk:"foundation_dns"`
+	MultiProvider      types.Bool                       `tfsdk:"multi_provider"`
+	NSTTL              types.Int64                      `tfsdk:"ns_ttl"`
+	SecondaryOverrides types.Bool                       `tfsdk:"secondary_overrides"`
+	ZoneMode           types.String                     `tfsdk:"zone_mode"`
+	Nameservers        *ZoneDNSSettingsNameserversModel `tfsdk:"nameservers"`
+	SOA                *ZoneDNSSettingsSOAModel         `tfsdk:"soa"`
+}
+
+type ZoneDNSSettingsNameserversModel struct {
+	Type  types.String `tfsdk:"type"`
+	NSSet types.Int64  `tfsdk:"ns_set"`
+}
+
+type ZoneDNSSettingsSOAModel struct {
+	Expire  types.Int64  `tfsdk:"expire"`
+	MinTTL  types.Int64  `tfsdk:"min_ttl"`
+	MName   types.String `tfsdk:"mname"`
+	Refresh types.Int64  `tfsdk:"refresh"`
+	Retry   types.Int64  `tfsdk:"retry"`
+	RName   types.String `tfsdk:"rname"`
+	TTL     types.Int64  `tfsdk:"ttl"`
+}
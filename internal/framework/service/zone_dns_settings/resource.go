@@ -0,0 +1,184 @@
+package zone_dns_settings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneDNSSettingsResource{}
+var _ resource.ResourceWithImportState = &ZoneDNSSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &ZoneDNSSettingsResource{}
+
+func NewResource() resource.Resource {
+	return &ZoneDNSSettingsResource{}
+}
+
+// ZoneDNSSettingsResource defines the resource implementation.
+type ZoneDNSSettingsResource struct {
+	client *cloudflare.API
+}
+
+func (r *ZoneDNSSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_dns_settings"
+}
+
+func (r *ZoneDNSSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneDNSSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *ZoneDNSSettingsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Nameservers == nil {
+		return
+	}
+
+	ns := data.Nameservers
+	if known(ns.NSSet) && !ns.Type.IsUnknown() && ns.Type.ValueString() != nameserversTypeCustomAccount {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("nameservers").AtName("ns_set"),
+			"invalid nameservers configuration",
+			fmt.Sprintf("ns_set can only be used when type is set to %q.", nameserversTypeCustomAccount),
+		)
+	}
+}
+
+func (r *ZoneDNSSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ZoneDNSSettingsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := r.writeSettings(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildZoneDNSSettingsModel(data, settings))...)
+}
+
+func (r *ZoneDNSSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ZoneDNSSettingsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.readSettings(ctx, data.ZoneID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("zone %s no longer exists", data.ZoneID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read zone DNS settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildZoneDNSSettingsModel(data, settings))...)
+}
+
+func (r *ZoneDNSSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ZoneDNSSettingsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := r.writeSettings(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildZoneDNSSettingsModel(data, settings))...)
+}
+
+func (r *ZoneDNSSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "removing zone DNS settings from state, the settings are left unchanged")
+}
+
+func (r *ZoneDNSSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (r *ZoneDNSSettingsResource) readSettings(ctx context.Context, zoneID string) (zoneDNSSettings, error) {
+	var settings zoneDNSSettings
+
+	res, err := r.client.Raw(ctx, http.MethodGet, settingsURI(zoneID), nil, nil)
+	if err != nil {
+		return settings, err
+	}
+
+	err = json.Unmarshal(res.Result, &settings)
+
+	return settings, err
+}
+
+// writeSettings updates the settings from the plan. The current settings
+// are read first so the API always receives complete nameservers and SOA
+// objects.
+func (r *ZoneDNSSettingsResource) writeSettings(ctx context.Context, data *ZoneDNSSettingsModel) (zoneDNSSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	zoneID := data.ZoneID.ValueString()
+
+	current, err := r.readSettings(ctx, zoneID)
+	if err != nil {
+		diags.AddError("failed to read zone DNS settings", err.Error())
+		return current, diags
+	}
+
+	res, err := r.client.Raw(ctx, http.MethodPatch, settingsURI(zoneID), mergeSettings(current, data), nil)
+	if err != nil {
+		diags.AddError("failed to update zone DNS settings", err.Error())
+		return current, diags
+	}
+
+	var settings zoneDNSSettings
+	if err := json.Unmarshal(res.Result, &settings); err != nil {
+		diags.AddError("failed to update zone DNS settings", err.Error())
+	}
+
+	return settings, diags
+}
+
+func settingsURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/dns_settings", zoneID)
+}
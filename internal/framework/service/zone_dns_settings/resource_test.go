@@ -0,0 +1,67 @@
+package zone_dns_settings_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareZoneDNSSettings_Basic(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_zone_dns_settings." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareZoneDNSSettingsConfig(rnd, zoneID, 3600, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "ns_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "soa.min_ttl", "1800"),
+					resource.TestCheckResourceAttrSet(resourceName, "soa.mname"),
+					resource.TestCheckResourceAttrSet(resourceName, "zone_mode"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareZoneDNSSettingsConfig(rnd, zoneID, 86400, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ns_ttl", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "soa.min_ttl", "3600"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           zoneID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"soa"},
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareZoneDNSSettingsConfig(rnd, zoneID string, nsTTL, minTTL int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_dns_settings" "%[1]s" {
+  zone_id = "%[2]s"
+  ns_ttl  = %[3]d
+
+  soa {
+    min_ttl = %[4]d
+  }
+}`, rnd, zoneID, nsTTL, minTTL)
+}
@@ -0,0 +1,168 @@
+package zone_dns_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const nameserversTypeCustomAccount = "custom.account"
+
+var (
+	zoneModes        = []string{"standard", "cdn_only", "dns_only"}
+	nameserversTypes = []string{"cloudflare.standard", nameserversTypeCustomAccount, "custom.tenant", "custom.zone"}
+)
+
+func (r *ZoneDNSSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Provides a Cloudflare resource to manage the DNS settings of a
+			zone, such as multi-provider DNS, Foundation DNS, the nameserver
+			TTL, the SOA record and which nameservers are assigned.
+
+			Settings that aren't configured are left unchanged and read back
+			from the zone. Destroying the resource removes it from state
+			without changing the settings.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			consts.IDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.IDSchemaDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: consts.ZoneIDSchemaDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"foundation_dns": schema.BoolAttribute{
+				MarkdownDescription: "Whether to enable Foundation DNS advanced nameservers on the zone.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"multi_provider": schema.BoolAttribute{
+				MarkdownDescription: "Whether to enable multi-provider DNS, which causes Cloudflare to activate the zone even when non-Cloudflare NS records exist, and to respect NS records at the zone apex during outbound zone transfers.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ns_ttl": schema.Int64Attribute{
+				MarkdownDescription: "The TTL of the zone's nameserver records, in seconds.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(30, 86400),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"secondary_overrides": schema.BoolAttribute{
+				MarkdownDescription: "Whether records in a secondary zone can be overridden by records created in Cloudflare.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_mode": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Whether the zone uses Cloudflare for both DNS and the CDN or only one of them. %s", utils.RenderAvailableDocumentationValuesStringSlice(zoneModes)),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(zoneModes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"nameservers": schema.SingleNestedBlock{
+				MarkdownDescription: "The nameservers assigned to the zone. Only managed when configured.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("The type of nameservers to assign. %s", utils.RenderAvailableDocumentationValuesStringSlice(nameserversTypes)),
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(nameserversTypes...),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"ns_set": schema.Int64Attribute{
+						MarkdownDescription: "The set of account custom nameservers to assign, as created by `cloudflare_custom_nameserver`. Only used with the `custom.account` type, which defaults to set `1`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 5),
+						},
+					},
+				},
+			},
+			"soa": schema.SingleNestedBlock{
+				MarkdownDescription: "The fields of the zone's SOA record. Only managed when configured.",
+				Attributes: map[string]schema.Attribute{
+					"expire":  soaInt64Attribute("The time, in seconds, after which secondary servers stop answering for the zone when the primary is unreachable.", 86400, 2419200),
+					"min_ttl": soaInt64Attribute("The TTL, in seconds, of negative responses for the zone.", 60, 86400),
+					"mname": schema.StringAttribute{
+						MarkdownDescription: "The primary nameserver, which may be overridden for zones with custom nameservers.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"refresh": soaInt64Attribute("The time, in seconds, after which secondary servers check the primary for updates.", 600, 86400),
+					"retry":   soaInt64Attribute("The time, in seconds, after which secondary servers retry a failed refresh.", 600, 86400),
+					"rname": schema.StringAttribute{
+						MarkdownDescription: "The email address of the zone administrator, with the first label representing the local part.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"ttl": soaInt64Attribute("The TTL, in seconds, of the SOA record itself.", 300, 86400),
+				},
+			},
+		},
+	}
+}
+
+func soaInt64Attribute(description string, min, max int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.Between(min, max),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
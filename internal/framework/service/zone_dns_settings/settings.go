@@ -0,0 +1,142 @@
+package zone_dns_settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// zoneDNSSettings is the API representation of the zone DNS settings.
+// cloudflare-go doesn't support the endpoint so it's called directly.
+type zoneDNSSettings struct {
+	FoundationDNS      bool                       `json:"foundation_dns"`
+	MultiProvider      bool                       `json:"multi_provider"`
+	NSTTL              int64                      `json:"ns_ttl,omitempty"`
+	SecondaryOverrides bool                       `json:"secondary_overrides"`
+	ZoneMode           string                     `json:"zone_mode,omitempty"`
+	Nameservers        zoneDNSSettingsNameservers `json:"nameservers"`
+	SOA                zoneDNSSettingsSOA         `json:"soa"`
+}
+
+type zoneDNSSettingsNameservers struct {
+	Type  string `json:"type"`
+	NSSet int64  `json:"ns_set,omitempty"`
+}
+
+type zoneDNSSettingsSOA struct {
+	Expire  int64  `json:"expire"`
+	MinTTL  int64  `json:"min_ttl"`
+	MName   string `json:"mname"`
+	Refresh int64  `json:"refresh"`
+	Retry   int64  `json:"retry"`
+	RName   string `json:"rname"`
+	TTL     int64  `json:"ttl"`
+}
+
+// known reports whether a planned value has been configured or carried over
+// from state, as opposed to being left for the API to compute.
+func known(v attr.Value) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
+// mergeSettings applies the known values of the plan over the current
+// settings so the settings that aren't managed are sent back unchanged.
+func mergeSettings(current zoneDNSSettings, data *ZoneDNSSettingsModel) zoneDNSSettings {
+	settings := current
+
+	if known(data.FoundationDNS) {
+		settings.FoundationDNS = data.FoundationDNS.ValueBool()
+	}
+	if known(data.MultiProvider) {
+		settings.MultiProvider = data.MultiProvider.ValueBool()
+	}
+	if known(data.NSTTL) {
+		settings.NSTTL = data.NSTTL.ValueInt64()
+	}
+	if known(data.SecondaryOverrides) {
+		settings.SecondaryOverrides = data.SecondaryOverrides.ValueBool()
+	}
+	if known(data.ZoneMode) {
+		settings.ZoneMode = data.ZoneMode.ValueString()
+	}
+
+	if ns := data.Nameservers; ns != nil {
+		if known(ns.Type) {
+			settings.Nameservers.Type = ns.Type.ValueString()
+		}
+		if known(ns.NSSet) {
+			settings.Nameservers.NSSet = ns.NSSet.ValueInt64()
+		}
+		// Nameserver sets only apply to account custom nameservers.
+		if settings.Nameservers.Type != nameserversTypeCustomAccount {
+			settings.Nameservers.NSSet = 0
+		}
+	}
+
+	if soa := data.SOA; soa != nil {
+		if known(soa.Expire) {
+			settings.SOA.Expire = soa.Expire.ValueInt64()
+		}
+		if known(soa.MinTTL) {
+			settings.SOA.MinTTL = soa.MinTTL.ValueInt64()
+		}
+		if known(soa.MName) {
+			settings.SOA.MName = soa.MName.ValueString()
+		}
+		if known(soa.Refresh) {
+			settings.SOA.Refresh = soa.Refresh.ValueInt64()
+		}
+		if known(soa.Retry) {
+			settings.SOA.Retry = soa.Retry.ValueInt64()
+		}
+		if known(soa.RName) {
+			settings.SOA.RName = soa.RName.ValueString()
+		}
+		if known(soa.TTL) {
+			settings.SOA.TTL = soa.TTL.ValueInt64()
+		}
+	}
+
+	return settings
+}
+
+// buildZoneDNSSettingsModel builds the state from the settings. The
+// nameservers and SOA blocks are only tracked when they're configured.
+func buildZoneDNSSettingsModel(data *ZoneDNSSettingsModel, settings zoneDNSSettings) *ZoneDNSSettingsModel {
+	model := &ZoneDNSSettingsModel{
+		ZoneID:             data.ZoneID,
+		ID:                 data.ZoneID,
+		FoundationDNS:      types.BoolValue(settings.FoundationDNS),
+		MultiProvider:      types.BoolValue(settings.MultiProvider),
+		NSTTL:              types.Int64Value(settings.NSTTL),
+		SecondaryOverrides: types.BoolValue(settings.SecondaryOverrides),
+		ZoneMode:           types.StringValue(settings.ZoneMode),
+	}
+
+	if data.Nameservers != nil {
+		// The API reports the default set for account custom nameservers,
+		// only track it when it's configured.
+		nsSet := types.Int64Null()
+		if !data.Nameservers.NSSet.IsNull() && settings.Nameservers.NSSet != 0 {
+			nsSet = types.Int64Value(settings.Nameservers.NSSet)
+		}
+
+		model.Nameservers = &ZoneDNSSettingsNameserversModel{
+			Type:  types.StringValue(settings.Nameservers.Type),
+			NSSet: nsSet,
+		}
+	}
+
+	if data.SOA != nil {
+		model.SOA = &ZoneDNSSettingsSOAModel{
+			Expire:  types.Int64Value(settings.SOA.Expire),
+			MinTTL:  types.Int64Value(settings.SOA.MinTTL),
+			MName:   types.StringValue(settings.SOA.MName),
+			Refresh: types.Int64Value(settings.SOA.Refresh),
+			Retry:   types.Int64Value(settings.SOA.Retry),
+			RName:   types.StringValue(settings.SOA.RName),
+			TTL:     types.Int64Value(settings.SOA.TTL),
+		}
+	}
+
+	return model
+}
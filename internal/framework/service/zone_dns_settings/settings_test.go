@@ -0,0 +1,107 @@
+package zone_dns_settings
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var currentSettings = zoneDNSSettings{
+	FoundationDNS:      false,
+	MultiProvider:      false,
+	NSTTL:              86400,
+	SecondaryOverrides: false,
+	ZoneMode:           "standard",
+	Nameservers: zoneDNSSettingsNameservers{
+		Type: "cloudflare.standard",
+	},
+	SOA: zoneDNSSettingsSOA{
+		Expire:  604800,
+		MinTTL:  1800,
+		MName:   "kristina.ns.cloudflare.com",
+		Refresh: 10000,
+		Retry:   2400,
+		RName:   "dns.cloudflare.com",
+		TTL:     3600,
+	},
+}
+
+func TestMergeSettingsKeepsUnmanagedSettings(t *testing.T) {
+	t.Parallel()
+
+	data := &ZoneDNSSettingsModel{
+		ZoneID:             types.StringValue("0da42c8d2132a9ddaf714f9e7c920711"),
+		FoundationDNS:      types.BoolUnknown(),
+		MultiProvider:      types.BoolValue(true),
+		NSTTL:              types.Int64Unknown(),
+		SecondaryOverrides: types.BoolNull(),
+		ZoneMode:           types.StringUnknown(),
+		SOA: &ZoneDNSSettingsSOAModel{
+			Expire:  types.Int64Unknown(),
+			MinTTL:  types.Int64Value(300),
+			MName:   types.StringUnknown(),
+			Refresh: types.Int64Unknown(),
+			Retry:   types.Int64Unknown(),
+			RName:   types.StringValue("hostmaster.example.com"),
+			TTL:     types.Int64Unknown(),
+		},
+	}
+
+	expected := currentSettings
+	expected.MultiProvider = true
+	expected.SOA.MinTTL = 300
+	expected.SOA.RName = "hostmaster.example.com"
+
+	assert.Equal(t, expected, mergeSettings(currentSettings, data))
+}
+
+func TestMergeSettingsNameservers(t *testing.T) {
+	t.Parallel()
+
+	current := currentSettings
+	current.Nameservers = zoneDNSSettingsNameservers{Type: "custom.account", NSSet: 2}
+
+	custom := mergeSettings(currentSettings, &ZoneDNSSettingsModel{
+		Nameservers: &ZoneDNSSettingsNameserversModel{
+			Type:  types.StringValue("custom.account"),
+			NSSet: types.Int64Value(2),
+		},
+	})
+	assert.Equal(t, zoneDNSSettingsNameservers{Type: "custom.account", NSSet: 2}, custom.Nameservers)
+
+	standard := mergeSettings(current, &ZoneDNSSettingsModel{
+		Nameservers: &ZoneDNSSettingsNameserversModel{
+			Type:  types.StringValue("cloudflare.standard"),
+			NSSet: types.Int64Null(),
+		},
+	})
+	assert.Equal(t, zoneDNSSettingsNameservers{Type: "cloudflare.standard"}, standard.Nameservers)
+}
+
+func TestBuildZoneDNSSettingsModelOnlyTracksConfiguredBlocks(t *testing.T) {
+	t.Parallel()
+
+	zoneID := types.StringValue("0da42c8d2132a9ddaf714f9e7c920711")
+
+	model := buildZoneDNSSettingsModel(&ZoneDNSSettingsModel{ZoneID: zoneID}, currentSettings)
+	assert.Equal(t, zoneID, model.ID)
+	assert.Equal(t, types.Int64Value(86400), model.NSTTL)
+	assert.Nil(t, model.Nameservers)
+	assert.Nil(t, model.SOA)
+
+	current := currentSettings
+	current.Nameservers = zoneDNSSettingsNameservers{Type: "custom.account", NSSet: 1}
+
+	model = buildZoneDNSSettingsModel(&ZoneDNSSettingsModel{
+		ZoneID: zoneID,
+		Nameservers: &ZoneDNSSettingsNameserversModel{
+			Type:  types.StringValue("custom.account"),
+			NSSet: types.Int64Null(),
+		},
+		SOA: &ZoneDNSSettingsSOAModel{},
+	}, current)
+	assert.Equal(t, &ZoneDNSSettingsNameserversModel{Type: types.StringValue("custom.account"), NSSet: types.Int64Null()}, model.Nameservers)
+	assert.Equal(t, types.StringValue("kristina.ns.cloudflare.com"), model.SOA.MName)
+	assert.Equal(t, types.Int64Value(3600), model.SOA.TTL)
+}
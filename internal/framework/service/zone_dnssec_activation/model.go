@@ -0,0 +1,11 @@
+package zone_dnssec_activation
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ZoneDNSSECActivationModel struct {
+	ZoneID   types.String `tfsdk:"zone_id"`
+	ID       types.String `tfsdk:"id"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	Timeout  types.String `tfsdk:"timeout"`
+	Status   types.String `tfsdk:"status"`
+}
@@ -0,0 +1,202 @@
+package zone_dnssec_activation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneDNSSECActivationResource{}
+var _ resource.ResourceWithImportState = &ZoneDNSSECActivationResource{}
+var _ resource.ResourceWithValidateConfig = &ZoneDNSSECActivationResource{}
+
+func NewResource() resource.Resource {
+	return &ZoneDNSSECActivationResource{}
+}
+
+// ZoneDNSSECActivationResource defines the resource implementation.
+type ZoneDNSSECActivationResource struct {
+	client *cloudflare.API
+}
+
+func (r *ZoneDNSSECActivationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_dnssec_activation"
+}
+
+func (r *ZoneDNSSECActivationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cloudflare.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *cloudflare.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneDNSSECActivationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *ZoneDNSSECActivationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Timeout.IsNull() || data.Timeout.IsUnknown() {
+		return
+	}
+
+	if timeout, err := time.ParseDuration(data.Timeout.ValueString()); err != nil || timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"invalid timeout",
+			fmt.Sprintf("%q is not a positive duration, use a value such as \"30m\" or \"2h\".", data.Timeout.ValueString()),
+		)
+	}
+}
+
+func (r *ZoneDNSSECActivationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ZoneDNSSECActivationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, _ := time.ParseDuration(data.Timeout.ValueString())
+	dnssec, err := waitForZoneDNSSECActive(ctx, r.client, data.ZoneID.ValueString(), timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to activate zone DNSSEC", err.Error())
+		return
+	}
+
+	data.ID = data.ZoneID
+	data.Status = types.StringValue(dnssec.Status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneDNSSECActivationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ZoneDNSSECActivationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dnssec, err := r.client.ZoneDNSSECSetting(ctx, data.ZoneID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("zone %s no longer exists", data.ZoneID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read zone DNSSEC", err.Error())
+		return
+	}
+
+	// DNSSEC was disabled since, waiting again requires a new resource.
+	if dnssec.Status == "disabled" {
+		tflog.Warn(ctx, fmt.Sprintf("zone DNSSEC %s is disabled", data.ZoneID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Status = types.StringValue(dnssec.Status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneDNSSECActivationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ZoneDNSSECActivationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only `timeout` can change in place, so DNSSEC that is already active
+	// needs no further checks.
+	if state.Status.ValueString() != "active" {
+		timeout, _ := time.ParseDuration(data.Timeout.ValueString())
+		dnssec, err := waitForZoneDNSSECActive(ctx, r.client, data.ZoneID.ValueString(), timeout)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to activate zone DNSSEC", err.Error())
+			return
+		}
+
+		data.Status = types.StringValue(dnssec.Status)
+	} else {
+		data.Status = state.Status
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneDNSSECActivationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ZoneDNSSECActivationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("removing zone DNSSEC activation for %s from state, the zone DNSSEC is left unchanged", data.ZoneID.ValueString()))
+}
+
+func (r *ZoneDNSSECActivationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.ZoneIDSchemaKey), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.IDSchemaKey), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), "30m")...)
+}
+
+// zoneDNSSECPollInterval is how often the DNSSEC status is polled while
+// waiting for the DS record to be picked up.
+const zoneDNSSECPollInterval = 30 * time.Second
+
+// waitForZoneDNSSECActive polls the DNSSEC status of a zone until it becomes
+// active, DNSSEC is disabled or the timeout expires. DNSSEC only becomes
+// active once the DS record has been published at the registrar.
+func waitForZoneDNSSECActive(ctx context.Context, client *cloudflare.API, zoneID string, timeout time.Duration) (cloudflare.ZoneDNSSEC, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		dnssec, err := client.ZoneDNSSECSetting(ctx, zoneID)
+		if err != nil {
+			return dnssec, fmt.Errorf("error finding zone DNSSEC %q: %w", zoneID, err)
+		}
+
+		switch dnssec.Status {
+		case "active":
+			return dnssec, nil
+		case "pending":
+		default:
+			return dnssec, fmt.Errorf("zone DNSSEC %q has status %q and can't become active, enable it with `cloudflare_zone_dnssec` first", zoneID, dnssec.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return dnssec, fmt.Errorf("timed out waiting for zone DNSSEC %q to become active (status %q), check the DS record %q has been published at the registrar", zoneID, dnssec.Status, dnssec.DS)
+		case <-time.After(zoneDNSSECPollInterval):
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package zone_dnssec_activation_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/acctest"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccCloudflareZoneDNSSECActivation_PendingDSRecord(t *testing.T) {
+	rnd := utils.GenerateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheck_Zone(t)
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The DS record of the test zone is never published at the
+				// registrar so DNSSEC stays pending.
+				Config:      testAccCheckCloudflareZoneDNSSECActivationConfig(rnd, zoneID),
+				ExpectError: regexp.MustCompile("timed out waiting for zone DNSSEC"),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareZoneDNSSECActivationConfig(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_dnssec" "%[1]s" {
+  zone_id = "%[2]s"
+}
+
+resource "cloudflare_zone_dnssec_activation" "%[1]s" {
+  zone_id = cloudflare_zone_dnssec.%[1]s.zone_id
+  timeout = "1m"
+}`, rnd, zoneID)
+}
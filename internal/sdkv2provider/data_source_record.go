@@ -7,6 +7,7 @@ import (
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -33,7 +34,7 @@ func dataSourceCloudflareRecord() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "A",
-				ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB"}, false),
+				ValidateFunc: validation.StringInSlice(utils.DNSRecordTypes, false),
 				Description:  "DNS record type to filter record results on.",
 			},
 			"content": {
@@ -100,7 +101,7 @@ func dataSourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("didn't get any DNS records for hostname: %s", searchRecord.Name)
 	}
 
-	if len(records) != 1 && !contains([]string{"MX", "URI"}, searchRecord.Type) {
+	if len(records) != 1 && !utils.Contains([]string{"MX", "URI"}, searchRecord.Type) {
 		return diag.Errorf("only wanted 1 DNS record. Got %d records", len(records))
 	} else {
 		var p uint16
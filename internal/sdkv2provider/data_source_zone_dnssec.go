@@ -71,6 +71,26 @@ func dataSourceCloudflareZoneDNSSEC() *schema.Resource {
 				Computed:    true,
 				Description: "Public Key for the Zone DNSSEC.",
 			},
+			"dnssec_multi_signer": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether multi-signer DNSSEC is enabled.",
+			},
+			"dnssec_presigned": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether DNSSEC records transferred to a secondary zone are served as-is.",
+			},
+			"ds_record": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data of the DS record to publish at the registrar, in the form `<key tag> <algorithm> <digest type> <digest>`.",
+			},
+			"dnskey_record": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data of the DNSKEY record, in the form `<flags> <protocol> <algorithm> <public key>`.",
+			},
 		},
 		Description: "Use this data source to look up Zone DNSSEC settings.",
 	}
@@ -83,7 +103,7 @@ func dataSourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceD
 
 	tflog.Debug(ctx, fmt.Sprintf("Reading Zone DNSSEC %s", zoneID))
 
-	dnssec, err := client.ZoneDNSSECSetting(ctx, zoneID)
+	dnssec, err := zoneDNSSECSetting(ctx, client, zoneID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error finding Zone DNSSEC %q: %w", zoneID, err))
 	}
@@ -99,6 +119,10 @@ func dataSourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceD
 	d.Set("ds", dnssec.DS)
 	d.Set("key_tag", dnssec.KeyTag)
 	d.Set("public_key", dnssec.PublicKey)
+	d.Set("dnssec_multi_signer", dnssec.MultiSigner)
+	d.Set("dnssec_presigned", dnssec.Presigned)
+	d.Set("ds_record", dnssecDSRecord(dnssec.ZoneDNSSEC))
+	d.Set("dnskey_record", dnssecDNSKEYRecord(dnssec.ZoneDNSSEC))
 
 	d.SetId(stringChecksum(dnssec.ModifiedOn.String()))
 
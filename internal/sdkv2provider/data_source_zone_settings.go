@@ -0,0 +1,128 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareZoneSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareZoneSettingsRead,
+
+		Schema: map[string]*schema.Schema{
+			consts.ZoneIDSchemaKey: {
+				Description: consts.ZoneIDSchemaDescription,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: computedOnlySchema(resourceCloudflareZoneSettingsSchema),
+				},
+				Description: "The settings of the zone, using the same attributes as `cloudflare_zone_settings_override`.",
+			},
+			"readonly_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The settings which can't be changed on the zone, e.g. because of its plan.",
+			},
+			"zone_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the zone.",
+			},
+			"zone_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the zone.",
+			},
+		},
+		Description: heredoc.Doc(`
+			Use this data source to look up all settings of a zone, for
+			example to assert TLS and security settings across the zones
+			returned by ` + "`cloudflare_zones`" + `.
+		`),
+	}
+}
+
+func dataSourceCloudflareZoneSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Zone Settings %s", zoneID))
+
+	zone, err := client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding zone %q: %w", zoneID, err))
+	}
+
+	zoneSettings, err := client.ZoneSettings(ctx, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading settings for zone %q: %w", zoneID, err))
+	}
+
+	if err = updateZoneSettingsResponseWithSingleZoneSettings(ctx, zoneSettings, zoneID, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = updateZoneSettingsResponseWithUniversalSSLSettings(ctx, zoneSettings, zoneID, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zoneID)
+	d.Set("zone_status", zone.Status)
+	d.Set("zone_type", zone.Type)
+
+	if err := d.Set("settings", flattenZoneSettings(ctx, d, zoneSettings.Result, true)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting settings for zone %q: %w", zoneID, err))
+	}
+
+	if err := d.Set("readonly_settings", flattenReadOnlyZoneSettings(ctx, zoneSettings.Result)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting readonly_settings for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+// computedOnlySchema returns a copy of a resource schema with every
+// attribute, including nested ones, made computed so it can be reused by a
+// data source.
+func computedOnlySchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	computed := make(map[string]*schema.Schema, len(s))
+
+	for k, v := range s {
+		computed[k] = computedOnlySchemaAttribute(v)
+	}
+
+	return computed
+}
+
+func computedOnlySchemaAttribute(s *schema.Schema) *schema.Schema {
+	attr := &schema.Schema{
+		Type:        s.Type,
+		Computed:    true,
+		Description: s.Description,
+		Sensitive:   s.Sensitive,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		attr.Elem = &schema.Resource{Schema: computedOnlySchema(elem.Schema)}
+	case *schema.Schema:
+		attr.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return attr
+}
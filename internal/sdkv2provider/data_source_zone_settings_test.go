@@ -0,0 +1,71 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareZoneSettingsDataSource(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_zone_settings.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSettingsDataSourceConfig(zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", zoneID),
+					resource.TestCheckResourceAttr(name, "zone_status", "active"),
+					resource.TestCheckResourceAttr(name, "settings.#", "1"),
+					resource.TestMatchResourceAttr(name, "settings.0.min_tls_version", regexp.MustCompile(`^1\.[0-3]$`)),
+					resource.TestMatchResourceAttr(name, "settings.0.ssl", regexp.MustCompile("^(off|flexible|full|strict|origin_pull)$")),
+					resource.TestMatchResourceAttr(name, "settings.0.universal_ssl", regexp.MustCompile("^(on|off)$")),
+					resource.TestCheckResourceAttrSet(name, "settings.0.security_level"),
+					resource.TestCheckResourceAttrSet(name, "settings.0.security_header.#"),
+					resource.TestCheckResourceAttrSet(name, "readonly_settings.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneSettingsDataSourceConfig(zoneID, rnd string) string {
+	return fmt.Sprintf(`
+data "cloudflare_zone_settings" "%[2]s" {
+  zone_id = "%[1]s"
+}
+`, zoneID, rnd)
+}
+
+func TestComputedOnlySchema(t *testing.T) {
+	var assertComputedOnly func(t *testing.T, path string, s map[string]*schema.Schema)
+	assertComputedOnly = func(t *testing.T, path string, s map[string]*schema.Schema) {
+		for k, v := range s {
+			assert.Truef(t, v.Computed, "%s%s must be computed", path, k)
+			assert.Falsef(t, v.Optional || v.Required, "%s%s must not be configurable", path, k)
+			assert.Nilf(t, v.ValidateFunc, "%s%s must not be validated", path, k)
+			assert.Zerof(t, v.MaxItems, "%s%s must not limit items", path, k)
+
+			if elem, ok := v.Elem.(*schema.Resource); ok {
+				assertComputedOnly(t, path+k+".", elem.Schema)
+			}
+		}
+	}
+
+	computed := computedOnlySchema(resourceCloudflareZoneSettingsSchema)
+
+	assert.Len(t, computed, len(resourceCloudflareZoneSettingsSchema))
+	assertComputedOnly(t, "", computed)
+
+	// The resource schema must be left untouched.
+	assert.True(t, resourceCloudflareZoneSettingsSchema["always_online"].Optional)
+}
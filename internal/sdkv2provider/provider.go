@@ -44,7 +44,7 @@ func init() {
 			}
 		}
 
-		if s.RequiredWith != nil && len(s.RequiredWith) > 0 && !contains(s.RequiredWith, consts.APIKeySchemaKey) {
+		if s.RequiredWith != nil && len(s.RequiredWith) > 0 && !utils.Contains(s.RequiredWith, consts.APIKeySchemaKey) {
 			requiredWith := make([]string, len(s.RequiredWith))
 			for i, c := range s.RequiredWith {
 				requiredWith[i] = fmt.Sprintf("`%s`", c)
@@ -52,7 +52,7 @@ func init() {
 			desc += fmt.Sprintf(" Required when using %s.", strings.Join(requiredWith, ", "))
 		}
 
-		if s.ConflictsWith != nil && len(s.ConflictsWith) > 0 && !contains(s.ConflictsWith, consts.APITokenSchemaKey) {
+		if s.ConflictsWith != nil && len(s.ConflictsWith) > 0 && !utils.Contains(s.ConflictsWith, consts.APITokenSchemaKey) {
 			conflicts := make([]string, len(s.ConflictsWith))
 			for i, c := range s.ConflictsWith {
 				conflicts[i] = fmt.Sprintf("`%s`", c)
@@ -60,7 +60,7 @@ func init() {
 			desc += fmt.Sprintf(" Conflicts with %s.", strings.Join(conflicts, ", "))
 		}
 
-		if s.ExactlyOneOf != nil && len(s.ExactlyOneOf) > 0 && (!contains(s.ExactlyOneOf, consts.APIKeySchemaKey) || !contains(s.ExactlyOneOf, consts.APITokenSchemaKey) || !contains(s.ExactlyOneOf, consts.APIUserServiceKeySchemaKey)) {
+		if s.ExactlyOneOf != nil && len(s.ExactlyOneOf) > 0 && (!utils.Contains(s.ExactlyOneOf, consts.APIKeySchemaKey) || !utils.Contains(s.ExactlyOneOf, consts.APITokenSchemaKey) || !utils.Contains(s.ExactlyOneOf, consts.APIUserServiceKeySchemaKey)) {
 			exactlyOneOfs := make([]string, len(s.ExactlyOneOf))
 			for i, c := range s.ExactlyOneOf {
 				exactlyOneOfs[i] = fmt.Sprintf("`%s`", c)
@@ -183,6 +183,7 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_zone_cache_reserve":         dataSourceCloudflareZoneCacheReserve(),
 				"cloudflare_tunnel":                     dataSourceCloudflareTunnel(),
 				"cloudflare_zone_dnssec":                dataSourceCloudflareZoneDNSSEC(),
+				"cloudflare_zone_settings":              dataSourceCloudflareZoneSettings(),
 				"cloudflare_zone":                       dataSourceCloudflareZone(),
 				"cloudflare_zones":                      dataSourceCloudflareZones(),
 			},
@@ -249,7 +250,6 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_notification_policy_webhooks":                    resourceCloudflareNotificationPolicyWebhook(),
 				"cloudflare_notification_policy":                             resourceCloudflareNotificationPolicy(),
 				"cloudflare_observatory_scheduled_test":                      resourceCloudflareObservatoryScheduledTest(),
-				"cloudflare_origin_ca_certificate":                           resourceCloudflareOriginCACertificate(),
 				"cloudflare_page_rule":                                       resourceCloudflarePageRule(),
 				"cloudflare_pages_domain":                                    resourceCloudflarePagesDomain(),
 				"cloudflare_pages_project":                                   resourceCloudflarePagesProject(),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,20 +13,28 @@ import (
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceCloudflareAuthenticatedOriginPullsCertificate() *schema.Resource {
 	return &schema.Resource{
-		// You cannot edit AOP certificates, rather, only upload new ones.
+		// You cannot edit AOP certificates, rather, only upload new ones. Only
+		// the hostnames served with a certificate are updated in place.
 		CreateContext: resourceCloudflareAuthenticatedOriginPullsCertificateCreate,
 		ReadContext:   resourceCloudflareAuthenticatedOriginPullsCertificateRead,
+		UpdateContext: resourceCloudflareAuthenticatedOriginPullsCertificateUpdate,
 		DeleteContext: resourceCloudflareAuthenticatedOriginPullsCertificateDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAuthenticatedOriginPullsCertificateImport,
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			resourceCloudflareAuthenticatedOriginPullsCertificateValidate,
+			resourceCloudflareAuthenticatedOriginPullsCertificateHostnamesDiff,
+		),
+
 		Schema: resourceCloudflareAuthenticatedOriginPullsCertificateSchema(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -34,6 +43,11 @@ func resourceCloudflareAuthenticatedOriginPullsCertificate() *schema.Resource {
 			Provides a Cloudflare Authenticated Origin Pulls certificate
 			resource. An uploaded client certificate is required to use Per-Zone
 			 or Per-Hostname Authenticated Origin Pulls.
+
+			Per-Hostname certificates are rotated by replacing the resource
+			with ` + "`create_before_destroy`" + ` set: the new certificate is uploaded
+			and must become active before ` + "`hostnames`" + ` are moved onto it, and the
+			previous certificate is only deleted afterwards.
 		`),
 	}
 }
@@ -85,25 +99,16 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateCreate(ctx context.Con
 		}
 		d.SetId(record.ID)
 
-		perHostnameRetryErr := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-			resp, err := client.GetPerHostnameAuthenticatedOriginPullsCertificate(ctx, zoneID, record.ID)
-			if err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error reading Per Hostname AOP certificate details: %w", err))
-			}
-
-			if resp.Status != "active" {
-				return retry.RetryableError(fmt.Errorf("expected Per Hostname AOP certificate to be active but was in state %s", resp.Status))
-			}
-
-			resourceCloudflareAuthenticatedOriginPullsCertificateRead(ctx, d, meta)
-			return nil
-		})
-
-		if perHostnameRetryErr != nil {
-			return diag.FromErr(perHostnameRetryErr)
+		if err := waitForPerHostnameAuthenticatedOriginPullsCertificate(ctx, client, zoneID, record.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
 		}
 
-		return nil
+		// The certificate exists at this point so hostnames which could not
+		// be enabled are left out of the state and retried on the next apply.
+		hostnames := sortedStringSet(d.Get("hostnames").(*schema.Set))
+		diags := editPerHostnameAuthenticatedOriginPullsHostnames(ctx, client, zoneID, record.ID, hostnames, true, diag.Warning)
+
+		return append(diags, resourceCloudflareAuthenticatedOriginPullsCertificateRead(ctx, d, meta)...)
 	}
 	return nil
 }
@@ -147,10 +152,50 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateRead(ctx context.Conte
 		d.Set("expires_on", record.ExpiresOn.Format(time.RFC3339Nano))
 		d.Set("status", record.Status)
 		d.Set("uploaded_on", record.UploadedOn.Format(time.RFC3339Nano))
+
+		// Hostnames which are no longer served with this certificate are
+		// dropped so that the next plan points them back at it.
+		served := []string{}
+		status := map[string]string{}
+		for _, hostname := range sortedStringSet(d.Get("hostnames").(*schema.Set)) {
+			config, err := client.GetPerHostnameAuthenticatedOriginPullsConfig(ctx, zoneID, hostname)
+			if err != nil {
+				var notFoundError *cloudflare.NotFoundError
+				if errors.As(err, &notFoundError) {
+					continue
+				}
+				return diag.FromErr(fmt.Errorf("error finding Per-Hostname Authenticated Origin Pulls config for hostname %s: %w", hostname, err))
+			}
+
+			if config.CertID != certID || !config.Enabled {
+				tflog.Info(ctx, fmt.Sprintf("hostname %s is no longer served with Per-Hostname Authenticated Origin Pull certificate %s", hostname, certID))
+				continue
+			}
+
+			served = append(served, hostname)
+			status[hostname] = config.Status
+		}
+		d.Set("hostnames", served)
+		d.Set("hostname_status", status)
 	}
 	return nil
 }
 
+func resourceCloudflareAuthenticatedOriginPullsCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	certID := d.Id()
+
+	oldHostnames, newHostnames := d.GetChange("hostnames")
+	added := newHostnames.(*schema.Set).Difference(oldHostnames.(*schema.Set))
+	removed := oldHostnames.(*schema.Set).Difference(newHostnames.(*schema.Set))
+
+	diags := editPerHostnameAuthenticatedOriginPullsHostnames(ctx, client, zoneID, certID, sortedStringSet(added), true, diag.Error)
+	diags = append(diags, editPerHostnameAuthenticatedOriginPullsHostnames(ctx, client, zoneID, certID, sortedStringSet(removed), false, diag.Error)...)
+
+	return append(diags, resourceCloudflareAuthenticatedOriginPullsCertificateRead(ctx, d, meta)...)
+}
+
 func resourceCloudflareAuthenticatedOriginPullsCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
@@ -163,7 +208,19 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateDelete(ctx context.Con
 			return diag.FromErr(fmt.Errorf("error deleting Per-Zone AOP certificate on zone %q: %w", zoneID, err))
 		}
 	case aopType == "per-hostname":
-		_, err := client.DeletePerHostnameAuthenticatedOriginPullsCertificate(ctx, zoneID, certID)
+		// Hostnames which have already been moved to another certificate,
+		// such as the one replacing this one with `create_before_destroy`,
+		// are left as they are.
+		hostnames, err := perHostnameAuthenticatedOriginPullsHostnamesUsing(ctx, client, zoneID, certID, sortedStringSet(d.Get("hostnames").(*schema.Set)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if diags := editPerHostnameAuthenticatedOriginPullsHostnames(ctx, client, zoneID, certID, hostnames, false, diag.Error); diags.HasError() {
+			return diags
+		}
+
+		_, err = client.DeletePerHostnameAuthenticatedOriginPullsCertificate(ctx, zoneID, certID)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error deleting Per-Hostname AOP certificate on zone %q: %w", zoneID, err))
 		}
@@ -186,3 +243,94 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateImport(ctx context.Con
 	resourceCloudflareAuthenticatedOriginPullsCertificateRead(ctx, d, meta)
 	return []*schema.ResourceData{d}, nil
 }
+
+// resourceCloudflareAuthenticatedOriginPullsCertificateValidate rejects the
+// hostname specific attributes on Per-Zone certificates.
+func resourceCloudflareAuthenticatedOriginPullsCertificateValidate(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("type").(string) == "per-hostname" {
+		return nil
+	}
+
+	if d.Get("hostnames").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("hostnames is only supported for per-hostname certificates")
+	}
+
+	return nil
+}
+
+// resourceCloudflareAuthenticatedOriginPullsCertificateHostnamesDiff marks
+// the hostname status as unknown when the served hostnames change.
+func resourceCloudflareAuthenticatedOriginPullsCertificateHostnamesDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("hostnames") {
+		return d.SetNewComputed("hostname_status")
+	}
+
+	return nil
+}
+
+// waitForPerHostnameAuthenticatedOriginPullsCertificate blocks until the
+// uploaded certificate is active and can be used by hostnames.
+func waitForPerHostnameAuthenticatedOriginPullsCertificate(ctx context.Context, client *cloudflare.API, zoneID, certID string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		resp, err := client.GetPerHostnameAuthenticatedOriginPullsCertificate(ctx, zoneID, certID)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error reading Per Hostname AOP certificate details: %w", err))
+		}
+
+		if resp.Status != "active" {
+			return retry.RetryableError(fmt.Errorf("expected Per Hostname AOP certificate to be active but was in state %s", resp.Status))
+		}
+
+		return nil
+	})
+}
+
+// editPerHostnameAuthenticatedOriginPullsHostnames points each hostname at
+// certID one at a time so that failures are reported per hostname.
+func editPerHostnameAuthenticatedOriginPullsHostnames(ctx context.Context, client *cloudflare.API, zoneID, certID string, hostnames []string, enabled bool, severity diag.Severity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, hostname := range hostnames {
+		_, err := client.EditPerHostnameAuthenticatedOriginPullsConfig(ctx, zoneID, []cloudflare.PerHostnameAuthenticatedOriginPullsConfig{{
+			CertID:   certID,
+			Hostname: hostname,
+			Enabled:  enabled,
+		}})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  fmt.Sprintf("failed to update Per-Hostname Authenticated Origin Pulls for %s", hostname),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return diags
+}
+
+// perHostnameAuthenticatedOriginPullsHostnamesUsing returns the hostnames
+// which are still served with certID.
+func perHostnameAuthenticatedOriginPullsHostnamesUsing(ctx context.Context, client *cloudflare.API, zoneID, certID string, hostnames []string) ([]string, error) {
+	var using []string
+	for _, hostname := range hostnames {
+		config, err := client.GetPerHostnameAuthenticatedOriginPullsConfig(ctx, zoneID, hostname)
+		if err != nil {
+			var notFoundError *cloudflare.NotFoundError
+			if errors.As(err, &notFoundError) {
+				continue
+			}
+			return nil, fmt.Errorf("error finding Per-Hostname Authenticated Origin Pulls config for hostname %s: %w", hostname, err)
+		}
+
+		if config.CertID == certID && config.Enabled {
+			using = append(using, hostname)
+		}
+	}
+
+	return using, nil
+}
+
+func sortedStringSet(set *schema.Set) []string {
+	values := expandInterfaceToStringList(set.List())
+	sort.Strings(values)
+	return values
+}
@@ -5,12 +5,17 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -124,6 +129,155 @@ func TestAccCloudflareAuthenticatedOriginPullsCertificatePerHostname(t *testing.
 	})
 }
 
+func TestAccCloudflareAuthenticatedOriginPullsCertificatePerHostname_CreateBeforeDestroy(t *testing.T) {
+	skipForDefaultZone(t, "Pending investigation into correct test setup for reproducibility.")
+
+	var original, rotated cloudflare.PerHostnameAuthenticatedOriginPullsCertificateDetails
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_authenticated_origin_pulls_certificate.%s", rnd)
+	hostname := fmt.Sprintf("%s.%s", rnd, domain)
+
+	cert, key, err := utils.GenerateEphemeralCertAndKey([]string{hostname}, time.Now().AddDate(0, 0, 30))
+	require.NoError(t, err)
+	renewedCert, renewedKey, err := utils.GenerateEphemeralCertAndKey([]string{hostname}, time.Now().AddDate(0, 0, 90))
+	require.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAuthenticatedOriginPullsCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareAuthenticatedOriginPullsCertificateCreateBeforeDestroyConfig(zoneID, rnd, hostname, cert, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareAuthenticatedOriginPullsCertificatePerHostnameExists(name, &original),
+					resource.TestCheckResourceAttr(name, "hostnames.#", "1"),
+					resource.TestCheckResourceAttrSet(name, fmt.Sprintf("hostname_status.%s", hostname)),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareAuthenticatedOriginPullsCertificateCreateBeforeDestroyConfig(zoneID, rnd, hostname, renewedCert, renewedKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareAuthenticatedOriginPullsCertificatePerHostnameExists(name, &rotated),
+					func(s *terraform.State) error {
+						if original.ID == rotated.ID {
+							return fmt.Errorf("expected certificate %s to be rotated", original.ID)
+						}
+
+						client := testAccProvider.Meta().(*cloudflare.API)
+						if _, err := client.GetPerHostnameAuthenticatedOriginPullsCertificate(context.Background(), zoneID, original.ID); err == nil {
+							return fmt.Errorf("expected previous certificate %s to be deleted", original.ID)
+						}
+
+						config, err := client.GetPerHostnameAuthenticatedOriginPullsConfig(context.Background(), zoneID, hostname)
+						if err != nil {
+							return err
+						}
+						if config.CertID != rotated.ID {
+							return fmt.Errorf("expected hostname %s to use certificate %s but found %s", hostname, rotated.ID, config.CertID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(name, "hostnames.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareAuthenticatedOriginPullsCertificateCustomizeDiff(t *testing.T) {
+	cert, key, err := utils.GenerateEphemeralCertAndKey([]string{"a.example.com"}, time.Now().AddDate(0, 0, 30))
+	require.NoError(t, err)
+	renewedCert, renewedKey, err := utils.GenerateEphemeralCertAndKey([]string{"a.example.com"}, time.Now().AddDate(0, 0, 90))
+	require.NoError(t, err)
+
+	state := &sdkterraform.InstanceState{
+		ID: "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60",
+		Attributes: map[string]string{
+			"id":                            "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60",
+			consts.ZoneIDSchemaKey:          "0da42c8d2132a9ddaf714f9e7c920711",
+			"certificate":                   cert,
+			"private_key":                   key,
+			"type":                          "per-hostname",
+			"hostnames.#":                   "1",
+			"hostnames.0":                   "a.example.com",
+			"hostname_status.%":             "1",
+			"hostname_status.a.example.com": "active",
+			"issuer":                        "example",
+			"status":                        "active",
+			"serial_number":                 "1",
+			"expires_on":                    time.Now().AddDate(0, 0, 30).Format(time.RFC3339Nano),
+			"uploaded_on":                   time.Now().Format(time.RFC3339Nano),
+			"signature":                     "SHA256WithRSA",
+		},
+	}
+
+	config := func(cert, key, aopType string, hostnames ...string) *sdkterraform.ResourceConfig {
+		raw := map[string]interface{}{
+			consts.ZoneIDSchemaKey: "0da42c8d2132a9ddaf714f9e7c920711",
+			"certificate":          cert,
+			"private_key":          key,
+			"type":                 aopType,
+		}
+		if len(hostnames) > 0 {
+			values := make([]interface{}, len(hostnames))
+			for i, hostname := range hostnames {
+				values[i] = hostname
+			}
+			raw["hostnames"] = values
+		}
+
+		return sdkterraform.NewResourceConfigRaw(raw)
+	}
+
+	testCases := map[string]struct {
+		state         *sdkterraform.InstanceState
+		config        *sdkterraform.ResourceConfig
+		expectedError string
+		requiresNew   bool
+		computed      []string
+	}{
+		"per-zone hostnames": {
+			config:        config(cert, key, "per-zone", "a.example.com"),
+			expectedError: "hostnames is only supported for per-hostname certificates",
+		},
+		"replaced certificate": {
+			state:       state,
+			config:      config(renewedCert, renewedKey, "per-hostname", "a.example.com"),
+			requiresNew: true,
+		},
+		"added hostname": {
+			state:    state,
+			config:   config(cert, key, "per-hostname", "a.example.com", "b.example.com"),
+			computed: []string{"hostname_status.%"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diff, err := resourceCloudflareAuthenticatedOriginPullsCertificate().Diff(context.Background(), tc.state, tc.config, nil)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, diff)
+			assert.Equal(t, tc.requiresNew, diff.RequiresNew())
+			for _, key := range tc.computed {
+				if assert.Contains(t, diff.Attributes, key) {
+					assert.True(t, diff.Attributes[key].NewComputed, key)
+				}
+			}
+		})
+	}
+}
+
 func testAccCheckCloudflareAuthenticatedOriginPullsCertificatePerZoneExists(n string, perZoneAOPCert *cloudflare.PerZoneAuthenticatedOriginPullsCertificateDetails) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -178,6 +332,23 @@ func testAccCheckCloudflareAuthenticatedOriginPullsCertificateConfig(zoneID, nam
   }`, zoneID, name, aopType)
 }
 
+func testAccCheckCloudflareAuthenticatedOriginPullsCertificateCreateBeforeDestroyConfig(zoneID, name, hostname, certificate, privateKey string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_authenticated_origin_pulls_certificate" "%[2]s" {
+	  zone_id     = "%[1]s"
+	  certificate = <<EOT
+%[4]sEOT
+	  private_key = <<EOT
+%[5]sEOT
+	  type        = "per-hostname"
+	  hostnames   = ["%[3]s"]
+
+	  lifecycle {
+	    create_before_destroy = true
+	  }
+  }`, zoneID, name, hostname, certificate, privateKey)
+}
+
 func testAccCheckCloudflareAuthenticatedOriginPullsCertificateDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 	for _, rs := range s.RootModule().Resources {
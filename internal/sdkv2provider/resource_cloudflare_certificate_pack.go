@@ -22,6 +22,7 @@ func resourceCloudflareCertificatePack() *schema.Resource {
 		Schema:        resourceCloudflareCertificatePackSchema(),
 		CreateContext: resourceCloudflareCertificatePackCreate,
 		ReadContext:   resourceCloudflareCertificatePackRead,
+		UpdateContext: resourceCloudflareCertificatePackUpdate,
 		DeleteContext: resourceCloudflareCertificatePackDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareCertificatePackImport,
@@ -72,23 +73,7 @@ func resourceCloudflareCertificatePackCreate(ctx context.Context, d *schema.Reso
 	}
 
 	if d.Get("wait_for_active_status").(bool) {
-		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *retry.RetryError {
-			certificatePack, err := client.CertificatePack(ctx, zoneID, certificatePackID)
-			if err != nil {
-				return retry.NonRetryableError(errors.Wrap(err, "failed to fetch certificate pack"))
-			}
-			if len(certificatePack.Certificates) == 0 {
-				return retry.RetryableError(fmt.Errorf("certificate list in response is empty"))
-			}
-			for _, certificate := range certificatePack.Certificates {
-				if certificate.Status != "active" {
-					return retry.RetryableError(fmt.Errorf("expected all certificates in certificate pack to be active state but certificate %s was in state %s", certificate.ID, certificate.Status))
-				}
-			}
-			return nil
-		})
-
-		if err != nil {
+		if err := waitForCertificatePackActive(ctx, client, zoneID, certificatePackID, d.Timeout(schema.TimeoutCreate)-time.Minute); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -114,6 +99,7 @@ func resourceCloudflareCertificatePackRead(ctx context.Context, d *schema.Resour
 	}
 
 	d.Set("type", certificatePack.Type)
+	d.Set("status", certificatePack.Status)
 	d.Set("hosts", expandStringListToSet(certificatePack.Hosts))
 
 	if !reflect.ValueOf(certificatePack.ValidationErrors).IsNil() {
@@ -143,6 +129,43 @@ func resourceCloudflareCertificatePackRead(ctx context.Context, d *schema.Resour
 	return nil
 }
 
+func resourceCloudflareCertificatePackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	var diags diag.Diagnostics
+	if d.HasChange("restart_validation") {
+		certificatePack, err := client.CertificatePack(ctx, zoneID, d.Id())
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, "failed to fetch certificate pack"))
+		}
+
+		// Validation can only be restarted for packs which haven't been
+		// issued yet, an active pack has nothing left to validate.
+		if certificatePack.Status == "active" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "certificate pack is already active",
+				Detail:   fmt.Sprintf("The validation of certificate pack %s was not restarted because the pack is already active.", d.Id()),
+			})
+		} else {
+			tflog.Info(ctx, fmt.Sprintf("restarting validation of certificate pack %s in status %s", d.Id(), certificatePack.Status))
+
+			if _, err := client.RestartCertificateValidation(ctx, zoneID, d.Id()); err != nil {
+				return diag.FromErr(errors.Wrap(err, "failed to restart certificate pack validation"))
+			}
+
+			if d.Get("wait_for_active_status").(bool) {
+				if err := waitForCertificatePackActive(ctx, client, zoneID, d.Id(), d.Timeout(schema.TimeoutUpdate)-time.Minute); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	}
+
+	return append(diags, resourceCloudflareCertificatePackRead(ctx, d, meta)...)
+}
+
 func resourceCloudflareCertificatePackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
@@ -175,3 +198,23 @@ func resourceCloudflareCertificatePackImport(ctx context.Context, d *schema.Reso
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// waitForCertificatePackActive polls the certificate pack until every
+// certificate in it is active.
+func waitForCertificatePackActive(ctx context.Context, client *cloudflare.API, zoneID, certificatePackID string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		certificatePack, err := client.CertificatePack(ctx, zoneID, certificatePackID)
+		if err != nil {
+			return retry.NonRetryableError(errors.Wrap(err, "failed to fetch certificate pack"))
+		}
+		if len(certificatePack.Certificates) == 0 {
+			return retry.RetryableError(fmt.Errorf("certificate list in response is empty"))
+		}
+		for _, certificate := range certificatePack.Certificates {
+			if certificate.Status != "active" {
+				return retry.RetryableError(fmt.Errorf("expected all certificates in certificate pack to be active state but certificate %s was in state %s", certificate.ID, certificate.Status))
+			}
+		}
+		return nil
+	})
+}
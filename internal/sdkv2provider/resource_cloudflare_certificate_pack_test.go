@@ -10,6 +10,7 @@ import (
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func init() {
@@ -132,3 +133,62 @@ resource "cloudflare_certificate_pack" "%[3]s" {
   wait_for_active_status = true
 }`, zoneID, domain, rnd, certType)
 }
+
+func TestAccCertificatePack_RestartValidation(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_certificate_pack." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	var certificatePackID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificatePackRestartValidationConfig(zoneID, domain, rnd, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "restart_validation.attempt", "1"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+					func(s *terraform.State) error {
+						certificatePackID = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCertificatePackRestartValidationConfig(zoneID, domain, rnd, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "restart_validation.attempt", "2"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[name].Primary.ID; id != certificatePackID {
+							return fmt.Errorf("certificate pack was replaced: %s != %s", id, certificatePackID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCertificatePackRestartValidationConfig(zoneID, domain, rnd, attempt string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_certificate_pack" "%[3]s" {
+  zone_id = "%[1]s"
+  type = "advanced"
+  hosts = [
+    "%[3]s.%[2]s",
+    "%[2]s"
+  ]
+  validation_method = "txt"
+  validity_days = 90
+  certificate_authority = "lets_encrypt"
+  cloudflare_branding = false
+
+  restart_validation = {
+    attempt = "%[4]s"
+  }
+}`, zoneID, domain, rnd, attempt)
+}
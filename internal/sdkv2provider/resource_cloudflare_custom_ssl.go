@@ -12,6 +12,8 @@ import (
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -27,6 +29,8 @@ func resourceCloudflareCustomSsl() *schema.Resource {
 			StateContext: resourceCloudflareCustomSslImport,
 		},
 
+		CustomizeDiff: resourceCloudflareCustomSslValidate,
+
 		SchemaVersion: 1,
 
 		Schema: resourceCloudflareCustomSslSchema(),
@@ -165,7 +169,22 @@ func resourceCloudflareCustomSslRead(ctx context.Context, d *schema.ResourceData
 	d.Set("expires_on", record.ExpiresOn.Format(time.RFC3339Nano))
 	d.Set("modified_on", record.ModifiedOn.Format(time.RFC3339Nano))
 	d.Set("priority", record.Priority)
-	return nil
+	return customSSLRenewalWarnings(d.Id(), record.ExpiresOn, d.Get("min_days_for_renewal").(int), time.Now())
+}
+
+// customSSLRenewalWarnings warns about a certificate expiring within
+// `min_days_for_renewal` days. It doesn't fail the plan so unrelated changes
+// can still be applied before a renewed certificate is supplied.
+func customSSLRenewalWarnings(certID string, expiresOn time.Time, minDays int, now time.Time) diag.Diagnostics {
+	if minDays <= 0 || expiresOn.IsZero() || now.Before(expiresOn.AddDate(0, 0, -minDays)) {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("custom SSL certificate %q is due for renewal", certID),
+		Detail:   fmt.Sprintf("the certificate expires on %s, within min_days_for_renewal (%d days): supply a renewed certificate", expiresOn.Format(time.RFC3339), minDays),
+	}}
 }
 
 func resourceCloudflareCustomSslDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -288,3 +307,106 @@ func flattenCustomSSLOptions(sslopt cloudflare.ZoneCustomSSLOptions) map[string]
 
 	return data
 }
+
+// resourceCloudflareCustomSslValidate checks the configured certificate
+// against its private key and the hostnames served by the certificate it
+// replaces.
+func resourceCloudflareCustomSslValidate(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("custom_ssl_options.0.certificate") || !d.NewValueKnown("custom_ssl_options.0.private_key") {
+		return nil
+	}
+
+	certificate := d.Get("custom_ssl_options.0.certificate").(string)
+	if certificate == "" {
+		return nil
+	}
+
+	// Malformed certificates are reported by the attribute validation.
+	chain, err := utils.ParseCertificateChain(certificate)
+	if err != nil {
+		return nil
+	}
+	leaf := chain[0]
+
+	if privateKey := d.Get("custom_ssl_options.0.private_key").(string); privateKey != "" {
+		key, err := utils.ParsePrivateKey(privateKey)
+		if err == nil && !utils.PrivateKeyMatchesCertificate(key, leaf) {
+			return fmt.Errorf("custom_ssl_options.0.private_key does not match the public key of the certificate for %q", leaf.Subject.String())
+		}
+	}
+
+	// When replacing a certificate, ensure the hostnames it served are still
+	// covered so the rotation doesn't cause an outage.
+	if d.Id() != "" && d.HasChange("custom_ssl_options.0.certificate") {
+		oldHosts, _ := d.GetChange("hosts")
+
+		var missing []string
+		for _, host := range oldHosts.([]interface{}) {
+			if !utils.CertificateCoversHostname(leaf, host.(string)) {
+				missing = append(missing, host.(string))
+			}
+		}
+
+		if len(missing) > 0 {
+			return fmt.Errorf("the new certificate does not cover %s, currently served by certificate %q: include them in the certificate's subject alternative names, or destroy this resource first to stop serving them", strings.Join(missing, ", "), d.Id())
+		}
+	}
+
+	return nil
+}
+
+func validateCustomSSLCertificate(v interface{}, path cty.Path) diag.Diagnostics {
+	chain, err := utils.ParseCertificateChain(v.(string))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "invalid certificate",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	var diags diag.Diagnostics
+	if err := utils.ValidateCertificateChainOrder(chain); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "invalid certificate chain order",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	for _, warning := range utils.CertificateKeyWarnings(chain) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "weak certificate",
+			Detail:        warning,
+			AttributePath: path,
+		})
+	}
+
+	if leaf := chain[0]; utils.CertificateExpiresWithin(leaf, 0) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "expired certificate",
+			Detail:        fmt.Sprintf("the certificate for %q expired on %s", leaf.Subject.String(), leaf.NotAfter.Format(time.RFC3339)),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+func validateCustomSSLPrivateKey(v interface{}, path cty.Path) diag.Diagnostics {
+	// The error never includes the key material.
+	if _, err := utils.ParsePrivateKey(v.(string)); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "invalid private key",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
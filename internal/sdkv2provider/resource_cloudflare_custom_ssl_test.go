@@ -6,11 +6,18 @@ import (
 	"os"
 	"regexp"
 	"testing"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccCloudflareCustomSSL_Basic(t *testing.T) {
@@ -129,3 +136,135 @@ resource "cloudflare_custom_ssl" "%[2]s" {
   }
 }`, zoneID, rName)
 }
+
+func TestValidateCustomSSLCertificate(t *testing.T) {
+	cert, key, err := utils.GenerateEphemeralCertAndKey([]string{"example.com"}, time.Now().AddDate(0, 0, 90))
+	require.NoError(t, err)
+
+	assert.Empty(t, validateCustomSSLCertificate(cert, cty.Path{}))
+	assert.Empty(t, validateCustomSSLPrivateKey(key, cty.Path{}))
+
+	diags := validateCustomSSLCertificate("-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n", cty.Path{})
+	assert.True(t, diags.HasError())
+
+	diags = validateCustomSSLPrivateKey("not a key", cty.Path{})
+	assert.True(t, diags.HasError())
+	assert.NotContains(t, diags[0].Detail, "not a key")
+
+	expired, _, err := utils.GenerateEphemeralCertAndKey([]string{"example.com"}, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	diags = validateCustomSSLCertificate(expired, cty.Path{})
+	assert.False(t, diags.HasError())
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "expired certificate", diags[0].Summary)
+	}
+}
+
+func TestResourceCloudflareCustomSslCustomizeDiff(t *testing.T) {
+	cert, key, err := utils.GenerateEphemeralCertAndKey([]string{"a.example.com", "b.example.com"}, time.Now().AddDate(0, 0, 90))
+	require.NoError(t, err)
+	_, otherKey, err := utils.GenerateEphemeralCertAndKey([]string{"a.example.com"}, time.Now().AddDate(0, 0, 90))
+	require.NoError(t, err)
+	partialCert, partialKey, err := utils.GenerateEphemeralCertAndKey([]string{"a.example.com"}, time.Now().AddDate(0, 0, 90))
+	require.NoError(t, err)
+	expiringCert, expiringKey, err := utils.GenerateEphemeralCertAndKey([]string{"a.example.com", "b.example.com"}, time.Now().AddDate(0, 0, 10))
+	require.NoError(t, err)
+
+	state := &sdkterraform.InstanceState{
+		ID: "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60",
+		Attributes: map[string]string{
+			"id":                                 "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60",
+			consts.ZoneIDSchemaKey:               "0da42c8d2132a9ddaf714f9e7c920711",
+			"custom_ssl_options.#":               "1",
+			"custom_ssl_options.0.certificate":   expiringCert,
+			"custom_ssl_options.0.private_key":   expiringKey,
+			"custom_ssl_options.0.bundle_method": "ubiquitous",
+			"hosts.#":                            "2",
+			"hosts.0":                            "a.example.com",
+			"hosts.1":                            "b.example.com",
+			"expires_on":                         time.Now().AddDate(0, 0, 10).Format(time.RFC3339Nano),
+		},
+	}
+
+	config := func(cert, key string, minDays int) *sdkterraform.ResourceConfig {
+		raw := map[string]interface{}{
+			consts.ZoneIDSchemaKey: "0da42c8d2132a9ddaf714f9e7c920711",
+			"custom_ssl_options": []interface{}{map[string]interface{}{
+				"certificate":   cert,
+				"private_key":   key,
+				"bundle_method": "ubiquitous",
+			}},
+		}
+		if minDays > 0 {
+			raw["min_days_for_renewal"] = minDays
+		}
+
+		return sdkterraform.NewResourceConfigRaw(raw)
+	}
+
+	testCases := map[string]struct {
+		state         *sdkterraform.InstanceState
+		config        *sdkterraform.ResourceConfig
+		expectedError string
+	}{
+		"new certificate": {
+			state:  nil,
+			config: config(cert, key, 30),
+		},
+		"mismatched private key": {
+			state:         nil,
+			config:        config(cert, otherKey, 0),
+			expectedError: "private_key does not match the public key of the certificate",
+		},
+		"renewed certificate": {
+			state:  state,
+			config: config(cert, key, 30),
+		},
+		"expiring certificate without renewal window": {
+			state:  state,
+			config: config(expiringCert, expiringKey, 0),
+		},
+		"expiring certificate within renewal window": {
+			state:  state,
+			config: config(expiringCert, expiringKey, 30),
+		},
+		"renewed certificate dropping hostnames": {
+			state:         state,
+			config:        config(partialCert, partialKey, 0),
+			expectedError: "the new certificate does not cover b.example.com",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diff, err := resourceCloudflareCustomSsl().Diff(context.Background(), tc.state, tc.config, nil)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tc.state != nil {
+				// Renewed certificates are uploaded in place.
+				assert.False(t, diff != nil && diff.RequiresNew())
+			}
+		})
+	}
+}
+
+func TestCustomSSLRenewalWarnings(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	assert.Empty(t, customSSLRenewalWarnings("cert", now.AddDate(0, 0, 10), 0, now))
+	assert.Empty(t, customSSLRenewalWarnings("cert", now.AddDate(0, 0, 60), 30, now))
+	assert.Empty(t, customSSLRenewalWarnings("cert", time.Time{}, 30, now))
+
+	diags := customSSLRenewalWarnings("cert", now.AddDate(0, 0, 10), 30, now)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Contains(t, diags[0].Detail, "within min_days_for_renewal (30 days)")
+	}
+}
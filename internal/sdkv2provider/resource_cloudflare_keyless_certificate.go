@@ -2,19 +2,45 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
+// keylessCertificate extends cloudflare.KeylessSSL with the Cloudflare
+// Tunnel routing the library does not model yet.
+type keylessCertificate struct {
+	cloudflare.KeylessSSL
+	Tunnel *keylessCertificateTunnel `json:"tunnel,omitempty"`
+}
+
+type keylessCertificateTunnel struct {
+	VnetID    string `json:"vnet_id"`
+	PrivateIP string `json:"private_ip"`
+}
+
+type keylessCertificateCreateRequest struct {
+	cloudflare.KeylessSSLCreateRequest
+	Tunnel *keylessCertificateTunnel `json:"tunnel,omitempty"`
+}
+
+type keylessCertificateUpdateRequest struct {
+	cloudflare.KeylessSSLUpdateRequest
+	Tunnel *keylessCertificateTunnel `json:"tunnel,omitempty"`
+}
+
 func resourceCloudflareKeylessCertificate() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareKeylessCertificateSchema(),
@@ -25,6 +51,15 @@ func resourceCloudflareKeylessCertificate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareKeylessCertificateImport,
 		},
+		// The tunnel can be repointed but not removed from an existing
+		// Keyless SSL configuration.
+		CustomizeDiff: customdiff.ForceNewIfChange("tunnel", func(ctx context.Context, old, new, meta interface{}) bool {
+			return len(old.([]interface{})) != len(new.([]interface{}))
+		}),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Description: heredoc.Doc(`
        			Provides a resource, that manages Keyless certificates.
        		`),
@@ -35,48 +70,38 @@ func resourceCloudflareKeylessCertificateCreate(ctx context.Context, d *schema.R
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
 
-	request := cloudflare.KeylessSSLCreateRequest{
-		Name:         d.Get("name").(string),
-		Host:         d.Get("host").(string),
-		Port:         d.Get("port").(int),
-		Certificate:  d.Get("certificate").(string),
-		BundleMethod: d.Get("bundle_method").(string),
+	request := keylessCertificateCreateRequest{
+		KeylessSSLCreateRequest: cloudflare.KeylessSSLCreateRequest{
+			Name:         d.Get("name").(string),
+			Host:         d.Get("host").(string),
+			Port:         d.Get("port").(int),
+			Certificate:  d.Get("certificate").(string),
+			BundleMethod: d.Get("bundle_method").(string),
+		},
+		Tunnel: expandKeylessCertificateTunnel(d.Get("tunnel").([]interface{})),
 	}
 
-	res, err := client.CreateKeylessSSL(ctx, zoneID, request)
-
+	res, err := createKeylessCertificate(ctx, client, zoneID, request)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("failed to create Keyless SSL")))
 	}
 
-	retry := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-		keylessSSL, err := client.KeylessSSL(ctx, zoneID, res.ID)
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("failed to fetch keyless certificate: %w", err))
-		}
+	d.SetId(res.ID)
 
-		if keylessSSL.Status != "active" {
-			return retry.RetryableError(fmt.Errorf("waiting for the keyless certificate to become active"))
+	if d.Get("wait_for_active").(bool) {
+		if err := waitForKeylessCertificateActive(ctx, client, zoneID, res.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Diagnostics{keylessCertificateUnreachableDiagnostic(d, err)}
 		}
-
-		d.SetId(res.ID)
-
-		resourceCloudflareKeylessCertificateRead(ctx, d, meta)
-		return nil
-	})
-
-	if retry != nil {
-		return diag.FromErr(retry)
 	}
 
-	return nil
+	return resourceCloudflareKeylessCertificateRead(ctx, d, meta)
 }
 
 func resourceCloudflareKeylessCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
 
-	keylessSSL, err := client.KeylessSSL(ctx, zoneID, d.Id())
+	keylessSSL, err := keylessCertificateDetails(ctx, client, zoneID, d.Id())
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -93,6 +118,7 @@ func resourceCloudflareKeylessCertificateRead(ctx context.Context, d *schema.Res
 	d.Set("status", keylessSSL.Status)
 	d.Set("enabled", keylessSSL.Enabled)
 	d.Set("port", keylessSSL.Port)
+	d.Set("tunnel", flattenKeylessCertificateTunnel(keylessSSL.Tunnel))
 	return nil
 }
 
@@ -100,18 +126,27 @@ func resourceCloudflareKeylessCertificateUpdate(ctx context.Context, d *schema.R
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
 
-	request := cloudflare.KeylessSSLUpdateRequest{
-		Name:    d.Get("name").(string),
-		Host:    d.Get("host").(string),
-		Port:    d.Get("port").(int),
-		Enabled: cloudflare.BoolPtr(d.Get("enabled").(bool)),
+	request := keylessCertificateUpdateRequest{
+		KeylessSSLUpdateRequest: cloudflare.KeylessSSLUpdateRequest{
+			Name:    d.Get("name").(string),
+			Host:    d.Get("host").(string),
+			Port:    d.Get("port").(int),
+			Enabled: cloudflare.BoolPtr(d.Get("enabled").(bool)),
+		},
+		Tunnel: expandKeylessCertificateTunnel(d.Get("tunnel").([]interface{})),
 	}
 
-	_, err := client.UpdateKeylessSSL(ctx, zoneID, d.Id(), request)
+	_, err := updateKeylessCertificate(ctx, client, zoneID, d.Id(), request)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("failed to update Keyless SSL")))
 	}
 
+	if d.Get("wait_for_active").(bool) && d.HasChanges("host", "port", "tunnel") {
+		if err := waitForKeylessCertificateActive(ctx, client, zoneID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Diagnostics{keylessCertificateUnreachableDiagnostic(d, err)}
+		}
+	}
+
 	return resourceCloudflareKeylessCertificateRead(ctx, d, meta)
 }
 
@@ -144,3 +179,102 @@ func resourceCloudflareKeylessCertificateImport(ctx context.Context, d *schema.R
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func keylessCertificateDetails(ctx context.Context, client *cloudflare.API, zoneID, keylessSSLID string) (keylessCertificate, error) {
+	var keylessSSL keylessCertificate
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/keyless_certificates/%s", zoneID, keylessSSLID), nil, nil)
+	if err != nil {
+		return keylessSSL, err
+	}
+
+	err = json.Unmarshal(res.Result, &keylessSSL)
+
+	return keylessSSL, err
+}
+
+func createKeylessCertificate(ctx context.Context, client *cloudflare.API, zoneID string, request keylessCertificateCreateRequest) (keylessCertificate, error) {
+	var keylessSSL keylessCertificate
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/keyless_certificates", zoneID), request, nil)
+	if err != nil {
+		return keylessSSL, err
+	}
+
+	err = json.Unmarshal(res.Result, &keylessSSL)
+
+	return keylessSSL, err
+}
+
+func updateKeylessCertificate(ctx context.Context, client *cloudflare.API, zoneID, keylessSSLID string, request keylessCertificateUpdateRequest) (keylessCertificate, error) {
+	var keylessSSL keylessCertificate
+
+	res, err := client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/keyless_certificates/%s", zoneID, keylessSSLID), request, nil)
+	if err != nil {
+		return keylessSSL, err
+	}
+
+	err = json.Unmarshal(res.Result, &keylessSSL)
+
+	return keylessSSL, err
+}
+
+func expandKeylessCertificateTunnel(tunnel []interface{}) *keylessCertificateTunnel {
+	if len(tunnel) == 0 || tunnel[0] == nil {
+		return nil
+	}
+
+	config := tunnel[0].(map[string]interface{})
+	return &keylessCertificateTunnel{
+		VnetID:    config["vnet_id"].(string),
+		PrivateIP: config["private_ip"].(string),
+	}
+}
+
+func flattenKeylessCertificateTunnel(tunnel *keylessCertificateTunnel) []interface{} {
+	if tunnel == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"vnet_id":    tunnel.VnetID,
+		"private_ip": tunnel.PrivateIP,
+	}}
+}
+
+func waitForKeylessCertificateActive(ctx context.Context, client *cloudflare.API, zoneID, keylessSSLID string, timeout time.Duration) error {
+	tflog.Info(ctx, fmt.Sprintf("Waiting for Keyless SSL %s to become active", keylessSSLID))
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		keylessSSL, err := keylessCertificateDetails(ctx, client, zoneID, keylessSSLID)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to fetch keyless certificate: %w", err))
+		}
+
+		switch keylessSSL.Status {
+		case "active":
+			return nil
+		case "deleted":
+			return retry.NonRetryableError(fmt.Errorf("keyless certificate %s has been deleted", keylessSSLID))
+		default:
+			return retry.RetryableError(fmt.Errorf("keyless certificate %s is %s", keylessSSLID, keylessSSL.Status))
+		}
+	})
+}
+
+// keylessCertificateUnreachableDiagnostic explains a failed wait in terms of
+// the key server Cloudflare was trying to reach.
+func keylessCertificateUnreachableDiagnostic(d *schema.ResourceData, err error) diag.Diagnostic {
+	target := fmt.Sprintf("%s:%d", d.Get("host").(string), d.Get("port").(int))
+	hint := "Check that the key server is running, that its firewall allows connections from Cloudflare's IP ranges and that the certificate has been added to its configuration."
+	if tunnel := expandKeylessCertificateTunnel(d.Get("tunnel").([]interface{})); tunnel != nil {
+		target = fmt.Sprintf("%s:%d in virtual network %s", tunnel.PrivateIP, d.Get("port").(int), tunnel.VnetID)
+		hint = "Check that the key server is running, that a healthy Cloudflare Tunnel in the virtual network routes the private IP and that the certificate has been added to the key server's configuration."
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "keyless certificate did not become active",
+		Detail:   fmt.Sprintf("Cloudflare could not confirm the key server at %s is reachable: %s. %s Set wait_for_active to false to skip this check.", target, err, hint),
+	}
+}
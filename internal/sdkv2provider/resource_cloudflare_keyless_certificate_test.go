@@ -11,9 +11,13 @@ import (
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccCloudflareKeylessSSL_Basic(t *testing.T) {
@@ -41,6 +45,102 @@ func TestAccCloudflareKeylessSSL_Basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareKeylessSSL_Tunnel(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_keyless_certificate.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareKeylessCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareKeylessCertificateTunnel(rnd, zoneID, accountID, domain, "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "tunnel.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "tunnel.0.vnet_id", "cloudflare_tunnel_virtual_network."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "tunnel.0.private_ip", "10.0.0.1"),
+					resource.TestCheckResourceAttr(name, "status", "active"),
+				),
+			},
+			{
+				Config: testAccCloudflareKeylessCertificateTunnel(rnd, zoneID, accountID, domain, "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "tunnel.0.private_ip", "10.0.0.2"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareKeylessCertificateTunnelDiff(t *testing.T) {
+	state := &sdkterraform.InstanceState{
+		ID: "4d2844d2ce78891c34d0b6c0535a291e",
+		Attributes: map[string]string{
+			"id":                   "4d2844d2ce78891c34d0b6c0535a291e",
+			consts.ZoneIDSchemaKey: "0da42c8d2132a9ddaf714f9e7c920711",
+			"certificate":          "certificate",
+			"bundle_method":        "ubiquitous",
+			"host":                 "keyless.example.com",
+			"port":                 "24008",
+			"wait_for_active":      "true",
+			"tunnel.#":             "1",
+			"tunnel.0.vnet_id":     "7365377a-85a4-4390-9480-531ef7dc7a3c",
+			"tunnel.0.private_ip":  "10.0.0.1",
+		},
+	}
+
+	config := func(tunnel ...map[string]interface{}) *sdkterraform.ResourceConfig {
+		raw := map[string]interface{}{
+			consts.ZoneIDSchemaKey: "0da42c8d2132a9ddaf714f9e7c920711",
+			"certificate":          "certificate",
+			"host":                 "keyless.example.com",
+		}
+		if len(tunnel) > 0 {
+			raw["tunnel"] = []interface{}{tunnel[0]}
+		}
+
+		return sdkterraform.NewResourceConfigRaw(raw)
+	}
+
+	diff, err := resourceCloudflareKeylessCertificate().Diff(context.Background(), state, config(map[string]interface{}{
+		"vnet_id":    "7365377a-85a4-4390-9480-531ef7dc7a3c",
+		"private_ip": "10.0.0.2",
+	}), nil)
+	require.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+
+	diff, err = resourceCloudflareKeylessCertificate().Diff(context.Background(), state, config(), nil)
+	require.NoError(t, err)
+	assert.True(t, diff.RequiresNew())
+}
+
+func TestKeylessCertificateUnreachableDiagnostic(t *testing.T) {
+	d := resourceCloudflareKeylessCertificate().TestResourceData()
+	d.Set("host", "keyless.example.com")
+	d.Set("port", 24008)
+
+	diagnostic := keylessCertificateUnreachableDiagnostic(d, errors.New("keyless certificate 4d2844d2 is pending"))
+	assert.Equal(t, diag.Error, diagnostic.Severity)
+	assert.Contains(t, diagnostic.Detail, "key server at keyless.example.com:24008 is reachable: keyless certificate 4d2844d2 is pending")
+	assert.Contains(t, diagnostic.Detail, "firewall")
+
+	d.Set("tunnel", []interface{}{map[string]interface{}{
+		"vnet_id":    "7365377a-85a4-4390-9480-531ef7dc7a3c",
+		"private_ip": "10.0.0.1",
+	}})
+
+	diagnostic = keylessCertificateUnreachableDiagnostic(d, errors.New("keyless certificate 4d2844d2 is pending"))
+	assert.Contains(t, diagnostic.Detail, "key server at 10.0.0.1:24008 in virtual network 7365377a-85a4-4390-9480-531ef7dc7a3c")
+	assert.Contains(t, diagnostic.Detail, "Cloudflare Tunnel")
+}
+
 func testAccCheckCloudflareKeylessCertificateDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
@@ -88,3 +188,30 @@ resource "cloudflare_keyless_certificate" "%[1]s" {
   EOT
 }`, resourceName, zoneId, domain, cert)
 }
+
+func testAccCloudflareKeylessCertificateTunnel(resourceName, zoneId, accountID, domain, privateIP string) string {
+	expiry := time.Now().Add(time.Hour * 730)
+	cert, _, _ := utils.GenerateEphemeralCertAndKey([]string{domain}, expiry)
+
+	return fmt.Sprintf(`
+resource "cloudflare_tunnel_virtual_network" "%[1]s" {
+  account_id = "%[3]s"
+  name       = "%[1]s"
+}
+
+resource "cloudflare_keyless_certificate" "%[1]s" {
+  zone_id       = "%[2]s"
+  bundle_method = "force"
+  name          = "%[1]s"
+  host          = "%[4]s"
+  port          = 24008
+  certificate   = <<EOT
+%[6]s
+  EOT
+
+  tunnel {
+    vnet_id    = cloudflare_tunnel_virtual_network.%[1]s.id
+    private_ip = "%[5]s"
+  }
+}`, resourceName, zoneId, accountID, domain, privateIP, cert)
+}
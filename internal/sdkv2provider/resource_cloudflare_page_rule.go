@@ -11,6 +11,7 @@ import (
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -260,16 +261,16 @@ func transformFromCloudflarePageRuleAction(pageRuleAction *cloudflare.PageRuleAc
 	key = pageRuleAction.ID
 
 	switch {
-	case contains(pageRuleAPIOnOffFields, pageRuleAction.ID):
+	case utils.Contains(pageRuleAPIOnOffFields, pageRuleAction.ID):
 		value = pageRuleAction.Value.(string)
 		break
 
-	case contains(pageRuleAPINilFields, pageRuleAction.ID):
+	case utils.Contains(pageRuleAPINilFields, pageRuleAction.ID):
 		// api returns a nil value so set the value ourselves
 		value = true
 		break
 
-	case contains(pageRuleAPIStringFields, pageRuleAction.ID):
+	case utils.Contains(pageRuleAPIStringFields, pageRuleAction.ID):
 		value = pageRuleAction.Value.(string)
 		break
 
@@ -380,13 +381,13 @@ func transformToCloudflarePageRuleAction(ctx context.Context, id string, value i
 		}
 	} else if unitValue, ok := value.(bool); ok {
 		if !unitValue {
-			if contains(pageRuleAPIOnOffFields, id) {
+			if utils.Contains(pageRuleAPIOnOffFields, id) {
 				pageRuleAction.Value = "off"
 			} else {
 				pageRuleAction.Value = nil
 			}
 		} else {
-			if contains(pageRuleAPIOnOffFields, id) {
+			if utils.Contains(pageRuleAPIOnOffFields, id) {
 				pageRuleAction.Value = "on"
 			} else {
 				pageRuleAction.Value = true
@@ -10,6 +10,7 @@ import (
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -25,6 +26,7 @@ func resourceCloudflareRecord() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareRecordImport,
 		},
+		CustomizeDiff: resourceCloudflareRecordValidate,
 		Description:   heredoc.Doc(`Provides a Cloudflare record resource.`),
 		SchemaVersion: 2,
 		Schema:        resourceCloudflareRecordSchema(),
@@ -69,7 +71,7 @@ func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData,
 	if dataOk {
 		dataMap := data.([]interface{})[0]
 		for id, value := range dataMap.(map[string]interface{}) {
-			newData, err := transformToCloudflareDNSData(newRecord.Type, id, value)
+			newData, err := utils.TransformToCloudflareDNSData(newRecord.Type, id, value)
 			if err != nil {
 				return diag.FromErr(err)
 			} else if newData == nil {
@@ -195,6 +197,66 @@ func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// resourceCloudflareRecordValidate validates the record content, data, TTL
+// and proxied state at plan time so invalid records don't first fail at
+// apply. Only values present in the configuration are checked as computed
+// values come from the API.
+func resourceCloudflareRecordValidate(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	configured := func(key string) bool {
+		return d.NewValueKnown(key) && !config.GetAttr(key).IsNull()
+	}
+
+	name := d.Get("name").(string)
+	recordType := d.Get("type").(string)
+
+	// An unknown proxied value is checked once it's known at apply.
+	proxied := false
+	if d.NewValueKnown("proxied") {
+		proxied = d.Get("proxied").(bool)
+	}
+
+	if err := validateRecordType(recordType, proxied); err != nil {
+		return fmt.Errorf("error validating record type %q: %w", recordType, err)
+	}
+
+	if configured("value") {
+		if err := validateRecordContent(recordType, d.Get("value").(string)); err != nil {
+			return fmt.Errorf("error validating record content of %q: %w", name, err)
+		}
+	}
+
+	if configured("data") {
+		if data, ok := d.Get("data").([]interface{}); ok && len(data) > 0 && data[0] != nil {
+			if err := validateRecordData(recordType, data[0].(map[string]interface{})); err != nil {
+				return fmt.Errorf("error validating record data of %q: %w", name, err)
+			}
+		}
+	}
+
+	if configured("ttl") {
+		if err := validateRecordTTL(d.Get("ttl").(int), proxied); err != nil {
+			return fmt.Errorf("error validating record %s: %w", name, err)
+		}
+	}
+
+	if configured("priority") {
+		if err := validateRecordPriority(d.Get("priority").(int)); err != nil {
+			return fmt.Errorf("error validating record %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
@@ -218,7 +280,7 @@ func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, m
 		dataMap := record.Data.(map[string]interface{})
 		if dataMap != nil {
 			for id, value := range dataMap {
-				newData, err := transformToCloudflareDNSData(record.Type, id, value)
+				newData, err := utils.TransformToCloudflareDNSData(record.Type, id, value)
 				if err != nil {
 					return diag.FromErr(err)
 				} else if newData == nil {
@@ -275,7 +337,7 @@ func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData,
 	if dataOk {
 		dataMap := data.([]interface{})[0]
 		for id, value := range dataMap.(map[string]interface{}) {
-			newData, err := transformToCloudflareDNSData(updateRecord.Type, id, value)
+			newData, err := utils.TransformToCloudflareDNSData(updateRecord.Type, id, value)
 			if err != nil {
 				return diag.FromErr(err)
 			} else if newData == nil {
@@ -399,64 +461,6 @@ func resourceCloudflareRecordImport(ctx context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
-var dnsTypeIntFields = []string{
-	"algorithm",
-	"key_tag",
-	"type",
-	"usage",
-	"selector",
-	"matching_type",
-	"weight",
-	"priority",
-	"port",
-	"long_degrees",
-	"lat_degrees",
-	"long_minutes",
-	"lat_minutes",
-	"protocol",
-	"digest_type",
-	"order",
-	"preference",
-}
-
-var dnsTypeFloatFields = []string{
-	"size",
-	"altitude",
-	"precision_horz",
-	"precision_vert",
-	"long_seconds",
-	"lat_seconds",
-}
-
-func transformToCloudflareDNSData(recordType string, id string, value interface{}) (newValue interface{}, err error) {
-	switch {
-	case id == "flags":
-		switch {
-		case strings.ToUpper(recordType) == "SRV":
-			newValue, err = value.(string), nil
-		case strings.ToUpper(recordType) == "NAPTR":
-			newValue, err = value.(string), nil
-		case strings.ToUpper(recordType) == "CAA", strings.ToUpper(recordType) == "DNSKEY":
-			// this is required because "flags" is shared however, it comes from
-			// the API as a float64 but the Terraform internal type is string 😢.
-			switch value.(type) {
-			case float64:
-				newValue, err = fmt.Sprintf("%.0f", value.(float64)), nil
-			case string:
-				newValue, err = value.(string), nil
-			}
-		}
-	case contains(dnsTypeIntFields, id):
-		newValue, err = value, nil
-	case contains(dnsTypeFloatFields, id):
-		newValue, err = value, nil
-	default:
-		newValue, err = value.(string), nil
-	}
-
-	return
-}
-
 func suppressPriority(k, old, new string, d *schema.ResourceData) bool {
 	recordType := d.Get("type").(string)
 	if recordType != "MX" && recordType != "URI" {
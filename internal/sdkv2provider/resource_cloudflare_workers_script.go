@@ -10,6 +10,7 @@ import (
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +27,7 @@ func resourceCloudflareWorkerScript() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareWorkerScriptImport,
 		},
+		CustomizeDiff: resourceCloudflareWorkerScriptValidateTailConsumers,
 		Description: heredoc.Doc(
 			"Provides a Cloudflare worker script resource. In order for a script to be active, you'll also need to setup a `cloudflare_worker_route`.",
 		),
@@ -70,84 +72,100 @@ func getWorkerScriptBindings(ctx context.Context, accountId, scriptName string,
 }
 
 func parseWorkerBindings(d *schema.ResourceData, bindings ScriptBindings) {
-	for _, rawData := range d.Get("kv_namespace_binding").(*schema.Set).List() {
+	utils.ParseWorkerBindings(func(name string) []interface{} {
+		return d.Get(name).(*schema.Set).List()
+	}, bindings)
+}
+
+func getPlacement(d *schema.ResourceData) cloudflare.Placement {
+	for _, rawData := range d.Get("placement").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerKvNamespaceBinding{
-			NamespaceID: data["namespace_id"].(string),
+		return cloudflare.Placement{
+			Mode: cloudflare.PlacementMode(data["mode"].(string)),
 		}
 	}
 
-	for _, rawData := range d.Get("plain_text_binding").(*schema.Set).List() {
+	return cloudflare.Placement{}
+}
+
+func getTailConsumers(d *schema.ResourceData) *[]cloudflare.WorkersTailConsumer {
+	tailConsumers := make([]cloudflare.WorkersTailConsumer, 0)
+	for _, rawData := range d.Get("tail_consumers").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerPlainTextBinding{
-			Text: data["text"].(string),
+		consumer := cloudflare.WorkersTailConsumer{
+			Service: data["service"].(string),
 		}
-	}
 
-	for _, rawData := range d.Get("secret_text_binding").(*schema.Set).List() {
-		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerSecretTextBinding{
-			Text: data["text"].(string),
+		if environment := data["environment"].(string); environment != "" {
+			consumer.Environment = cloudflare.StringPtr(environment)
 		}
-	}
 
-	for _, rawData := range d.Get("webassembly_binding").(*schema.Set).List() {
-		data := rawData.(map[string]interface{})
-		module := base64.NewDecoder(base64.StdEncoding, strings.NewReader(data["module"].(string)))
-		bindings[data["name"].(string)] = cloudflare.WorkerWebAssemblyBinding{
-			Module: module,
+		if namespace := data["namespace"].(string); namespace != "" {
+			consumer.Namespace = cloudflare.StringPtr(namespace)
 		}
+
+		tailConsumers = append(tailConsumers, consumer)
 	}
+	return &tailConsumers
+}
 
-	for _, rawData := range d.Get("service_binding").(*schema.Set).List() {
-		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerServiceBinding{
-			Service:     data["service"].(string),
-			Environment: cloudflare.StringPtr(data["environment"].(string)),
-		}
+// resourceCloudflareWorkerScriptValidateTailConsumers rejects scripts which
+// tail themselves. Whether the tail consumers exist is only checked once the
+// script is uploaded since they may be created in the same apply.
+func resourceCloudflareWorkerScriptValidateTailConsumers(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tail_consumers") || !d.NewValueKnown("name") {
+		return nil
 	}
 
-	for _, rawData := range d.Get("r2_bucket_binding").(*schema.Set).List() {
+	for _, rawData := range d.Get("tail_consumers").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerR2BucketBinding{
-			BucketName: data["bucket_name"].(string),
+		if data["namespace"].(string) == "" && data["service"].(string) == d.Get("name").(string) {
+			return fmt.Errorf("tail consumer %q cannot be the script itself", data["service"].(string))
 		}
 	}
 
-	for _, rawData := range d.Get("analytics_engine_binding").(*schema.Set).List() {
+	return nil
+}
+
+// workerScriptTailConsumerWarnings warns about tail consumers outside of a
+// dispatch namespace which don't reference a Worker script in the account.
+func workerScriptTailConsumerWarnings(ctx context.Context, client *cloudflare.API, accountID string, d *schema.ResourceData) diag.Diagnostics {
+	var services []string
+	for _, rawData := range d.Get("tail_consumers").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerAnalyticsEngineBinding{
-			Dataset: data["dataset"].(string),
+		if data["namespace"].(string) != "" || data["service"].(string) == "" {
+			continue
 		}
+		services = append(services, data["service"].(string))
 	}
 
-	for _, rawData := range d.Get("queue_binding").(*schema.Set).List() {
-		data := rawData.(map[string]interface{})
-
-		bindings[data["binding"].(string)] = cloudflare.WorkerQueueBinding{
-			Binding: data["binding"].(string),
-			Queue:   data["queue"].(string),
-		}
+	if len(services) == 0 {
+		return nil
 	}
 
-	for _, rawData := range d.Get("d1_database_binding").(*schema.Set).List() {
-		data := rawData.(map[string]interface{})
+	scripts, _, err := client.ListWorkers(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListWorkersParams{})
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("failed to list worker scripts to validate tail consumers: %s", err))
+		return nil
+	}
 
-		bindings[data["name"].(string)] = cloudflare.WorkerD1DatabaseBinding{
-			DatabaseID: data["database_id"].(string),
-		}
+	existing := make(map[string]bool, len(scripts.WorkerList))
+	for _, script := range scripts.WorkerList {
+		existing[script.ID] = true
 	}
-}
 
-func getPlacement(d *schema.ResourceData) cloudflare.Placement {
-	for _, rawData := range d.Get("placement").(*schema.Set).List() {
-		data := rawData.(map[string]interface{})
-		return cloudflare.Placement{
-			Mode: cloudflare.PlacementMode(data["mode"].(string)),
+	var diags diag.Diagnostics
+	for _, service := range services {
+		if !existing[service] {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("tail consumer %q does not exist in account %q", service, accountID),
+				Detail:   "The script's events are not delivered until a Worker script with this name is uploaded.",
+			})
 		}
 	}
 
-	return cloudflare.Placement{}
+	return diags
 }
 
 func getCompatibilityFlags(d *schema.ResourceData) []string {
@@ -196,6 +214,7 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 		Module:             d.Get("module").(bool),
 		Bindings:           bindings,
 		Logpush:            &logpush,
+		TailConsumers:      getTailConsumers(d),
 		Placement:          &placement,
 	})
 	if err != nil {
@@ -204,7 +223,7 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 
 	d.SetId(scriptData.ID)
 
-	return nil
+	return workerScriptTailConsumerWarnings(ctx, client, accountID, d)
 }
 
 func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -309,6 +328,22 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	settings, err := client.GetWorkersScriptSettings(ctx, cloudflare.AccountIdentifier(accountID), scriptData.Params.ScriptName)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error reading worker script settings"))
+	}
+
+	tailConsumers := &schema.Set{F: schema.HashResource(tailConsumerResource)}
+	if settings.TailConsumers != nil {
+		for _, consumer := range *settings.TailConsumers {
+			tailConsumers.Add(map[string]interface{}{
+				"service":     consumer.Service,
+				"environment": cloudflare.String(consumer.Environment),
+				"namespace":   cloudflare.String(consumer.Namespace),
+			})
+		}
+	}
+
 	if err := d.Set("content", r.Script); err != nil {
 		return diag.FromErr(fmt.Errorf("cannot set content: %w", err))
 	}
@@ -351,6 +386,10 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("cannot set d1 database bindings (%s): %w", d.Id(), err))
 	}
 
+	if err := d.Set("tail_consumers", tailConsumers); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set tail consumers (%s): %w", d.Id(), err))
+	}
+
 	d.SetId(scriptData.ID)
 
 	return nil
@@ -388,13 +427,14 @@ func resourceCloudflareWorkerScriptUpdate(ctx context.Context, d *schema.Resourc
 		Module:             d.Get("module").(bool),
 		Bindings:           bindings,
 		Logpush:            &logpush,
+		TailConsumers:      getTailConsumers(d),
 		Placement:          &placement,
 	})
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error updating worker script"))
 	}
 
-	return nil
+	return workerScriptTailConsumerWarnings(ctx, client, accountID, d)
 }
 
 func resourceCloudflareWorkerScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
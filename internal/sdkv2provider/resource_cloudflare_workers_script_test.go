@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -109,6 +110,65 @@ func TestAccCloudflareWorkerScript_ModuleUpload(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWorkerScript_TailConsumers(t *testing.T) {
+	t.Parallel()
+
+	var script cloudflare.WorkerScript
+	rnd := generateRandomResourceName()
+	name := "cloudflare_worker_script." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWorkerScriptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareWorkerScriptConfigTailConsumers(rnd, accountID, fmt.Sprintf("%q", rnd)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`tail consumer "%s" cannot be the script itself`, rnd)),
+			},
+			{
+				// The tail consumer is created in the same apply as its producer.
+				Config: testAccCheckCloudflareWorkerScriptConfigTailConsumers(rnd, accountID, fmt.Sprintf("cloudflare_worker_script.%s-tail.name", rnd)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerScriptExists(name, &script, nil),
+					resource.TestCheckResourceAttr(name, "tail_consumers.#", "1"),
+					resource.TestCheckResourceAttr(name, "tail_consumers.0.service", rnd+"-tail"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareWorkerScriptConfigTailConsumer(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s-tail" {
+  account_id = "%[3]s"
+  name       = "%[1]s-tail"
+  content    = "%[2]s"
+  module     = true
+}`, rnd, moduleContent, accountID)
+}
+
+func testAccCheckCloudflareWorkerScriptConfigTailConsumers(rnd, accountID, service string) string {
+	return testAccCheckCloudflareWorkerScriptConfigTailConsumer(rnd, accountID) + fmt.Sprintf(`
+
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[3]s"
+  name       = "%[1]s"
+  content    = "%[2]s"
+  module     = true
+
+  tail_consumers {
+    service = %[4]s
+  }
+}`, rnd, moduleContent, accountID, service)
+}
+
 // We can't currently use `cloudflare_r2_bucket` here due to not being able to
 // mix V5 and V6 protocol resources without circular dependencies. In an ideal
 // world, this would all be handled by the inbuilt resource.
@@ -5,12 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
+	"time"
 
 	"golang.org/x/net/idna"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -96,9 +100,14 @@ func resourceCloudflareZone() *schema.Resource {
 		ReadContext:   resourceCloudflareZoneRead,
 		UpdateContext: resourceCloudflareZoneUpdate,
 		DeleteContext: resourceCloudflareZoneDelete,
+		CustomizeDiff: resourceCloudflareZoneCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Zone resource. Zone is the basic resource for
 			working with Cloudflare and is roughly equivalent to a domain name
@@ -138,7 +147,7 @@ func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if plan, ok := d.GetOk("plan"); ok {
-		if err := setRatePlan(ctx, client, zone.ID, plan.(string), true, d); err != nil {
+		if err := setRatePlan(ctx, client, zone.ID, plan.(string), true, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -150,7 +159,12 @@ func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	return resourceCloudflareZoneRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if d.Get("wait_for_activation").(bool) {
+		diags = waitForZoneActivationWarnings(ctx, client, zone.ID, d.Timeout(schema.TimeoutCreate))
+	}
+
+	return append(diags, resourceCloudflareZoneRead(ctx, d, meta)...)
 }
 
 func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -203,6 +217,27 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[INFO] Updating Cloudflare Zone: id %s", zoneID)
 
+	var diags diag.Diagnostics
+	if d.HasChange(consts.AccountIDSchemaKey) {
+		oldAccountID, newAccountID := d.GetChange(consts.AccountIDSchemaKey)
+
+		// Guarded at plan time already but moving a zone is disruptive
+		// enough to check again.
+		if !d.Get("allow_account_move").(bool) {
+			return diag.FromErr(zoneAccountMoveError(zoneID, oldAccountID.(string), newAccountID.(string)))
+		}
+
+		if err := moveZoneAccount(ctx, client, zoneID, newAccountID.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("zone %q moved to account %q", zoneID, newAccountID),
+			Detail:   fmt.Sprintf("The zone and its zone level configuration were moved from account %q. Account level resources referencing the zone, such as rulesets, load balancer pools or Access applications, remain in the previous account and need to be recreated in the new one.", oldAccountID),
+		})
+	}
+
 	if paused, ok := d.GetOkExists("paused"); ok && d.HasChange("paused") {
 		log.Printf("[DEBUG] _ paused")
 
@@ -236,12 +271,31 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 		wasFreePlan := existingPlan.(string) == "free"
 		planID := newPlan.(string)
 
-		if err := setRatePlan(ctx, client, zoneID, planID, wasFreePlan, d); err != nil {
+		if err := setRatePlan(ctx, client, zoneID, planID, wasFreePlan, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	return resourceCloudflareZoneRead(ctx, d, meta)
+	if d.Get("wait_for_activation").(bool) && zone.Status != "active" {
+		diags = append(diags, waitForZoneActivationWarnings(ctx, client, zoneID, d.Timeout(schema.TimeoutUpdate))...)
+	}
+
+	return append(diags, resourceCloudflareZoneRead(ctx, d, meta)...)
+}
+
+// waitForZoneActivationWarnings waits for the zone to become active. A zone
+// that doesn't is only reported as a warning as failing would taint a newly
+// created zone.
+func waitForZoneActivationWarnings(ctx context.Context, client *cloudflare.API, zoneID string, timeout time.Duration) diag.Diagnostics {
+	if _, err := utils.WaitForActiveZone(ctx, client, zoneID, timeout); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("zone %q is not active yet", zoneID),
+			Detail:   err.Error() + ". Use `cloudflare_zone_activation` to keep waiting in a later run.",
+		}}
+	}
+
+	return nil
 }
 
 func resourceCloudflareZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -270,9 +324,85 @@ func flattenMeta(d *schema.ResourceData, meta cloudflare.ZoneMeta) map[string]in
 	return cfg
 }
 
+// resourceCloudflareZoneCustomizeDiff guards changes which would otherwise
+// only fail, or surprise, at apply time. Neither an account nor a plan change
+// replaces the zone.
+func resourceCloudflareZoneCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange(consts.AccountIDSchemaKey) {
+		oldAccountID, newAccountID := d.GetChange(consts.AccountIDSchemaKey)
+
+		if !d.Get("allow_account_move").(bool) {
+			return zoneAccountMoveError(d.Id(), oldAccountID.(string), newAccountID.(string))
+		}
+
+		if d.HasChange("plan") {
+			return fmt.Errorf("zone %q can't be moved to account %q and change plan at the same time: apply the account move first, then the plan change, as the rate plan is billed to the owning account", d.Id(), newAccountID)
+		}
+	}
+
+	if d.HasChange("plan") {
+		oldPlan, newPlan := d.GetChange("plan")
+		if err := validateZonePlanChange(oldPlan.(string), newPlan.(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func zoneAccountMoveError(zoneID, oldAccountID, newAccountID string) error {
+	return fmt.Errorf("changing account_id of zone %q from %q to %q moves the zone between accounts, which is not undone by reverting the change. Set allow_account_move = true to confirm the move, or remove the zone from the state and import it into the configuration for the other account instead", zoneID, oldAccountID, newAccountID)
+}
+
+// validateZonePlanChange ensures a plan change can be made in place on the
+// zone subscription.
+func validateZonePlanChange(oldPlan, newPlan string) error {
+	if oldPlan == "" || newPlan == "" {
+		return nil
+	}
+
+	if isPartnerRatePlan(oldPlan) != isPartnerRatePlan(newPlan) {
+		return fmt.Errorf("plan can't be changed from %q to %q in place: partner plans and self-serve plans are separate subscriptions. Pick a plan of the same kind, or change the subscription outside of Terraform and update the configuration to match", oldPlan, newPlan)
+	}
+
+	return nil
+}
+
+func isPartnerRatePlan(planID string) bool {
+	return strings.HasPrefix(planID, "partners_")
+}
+
+// moveZoneAccount moves the zone into another account and waits for the
+// change to be reflected on the zone.
+func moveZoneAccount(ctx context.Context, client *cloudflare.API, zoneID, accountID string) error {
+	tflog.Info(ctx, fmt.Sprintf("Moving Cloudflare Zone %s to account %s", zoneID, accountID))
+
+	body := map[string]interface{}{
+		"account": map[string]string{"id": accountID},
+	}
+	if _, err := client.Raw(ctx, http.MethodPatch, "/zones/"+zoneID, body, nil); err != nil {
+		return fmt.Errorf("error moving zone %q to account %q: %w", zoneID, accountID, err)
+	}
+
+	zone, err := client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("error finding zone %q after moving it: %w", zoneID, err)
+	}
+
+	if zone.Account.ID != accountID {
+		return fmt.Errorf("zone %q was not moved to account %q and still belongs to account %q: the API token needs access to both accounts", zoneID, accountID, zone.Account.ID)
+	}
+
+	return nil
+}
+
 // setRatePlan handles the internals of creating or updating a zone
 // subscription rate plan.
-func setRatePlan(ctx context.Context, client *cloudflare.API, zoneID, planID string, isNewPlan bool, d *schema.ResourceData) error {
+func setRatePlan(ctx context.Context, client *cloudflare.API, zoneID, planID string, isNewPlan bool, timeout time.Duration) error {
 	if isNewPlan {
 		// A free rate plan is the default so no need to explicitly make another
 		// HTTP call to set it.
@@ -287,8 +417,17 @@ func setRatePlan(ctx context.Context, client *cloudflare.API, zoneID, planID str
 		}
 	}
 
-	return retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-		zone, _ := client.ZoneDetails(ctx, zoneID)
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		zone, err := client.ZoneDetails(ctx, zoneID)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error finding zone %q: %w", zoneID, err))
+		}
+
+		// Zones which aren't active yet hold the new plan as pending.
+		plan := zone.Plan
+		if zone.Status == "pending" && zone.PlanPending.Name != "" {
+			plan = zone.PlanPending
+		}
 
 		// This is a little confusing but due to the multiple views of
 		// subscriptions, partner plans actually end up "appearing" like regular
@@ -298,7 +437,7 @@ func setRatePlan(ctx context.Context, client *cloudflare.API, zoneID, planID str
 		// subscriptions service, we will compare it in Terraform as
 		// "Enterprise Website" and know that we made the swap and just trust
 		// that the rate plan identifier did the right thing.
-		if zone.Plan.Name != ratePlans[planID].Description {
+		if plan.Name != ratePlans[planID].Description {
 			return retry.RetryableError(fmt.Errorf("plan ID change has not yet propagated"))
 		}
 
@@ -2,7 +2,9 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -21,6 +23,20 @@ const (
 	DNSSECStatusDisabled = "disabled"
 )
 
+// zoneDNSSEC extends the DNSSEC details with the multi-signer and presigned
+// settings which cloudflare-go doesn't expose.
+type zoneDNSSEC struct {
+	cloudflare.ZoneDNSSEC
+	MultiSigner bool `json:"dnssec_multi_signer"`
+	Presigned   bool `json:"dnssec_presigned"`
+}
+
+type zoneDNSSECUpdateOptions struct {
+	Status      string `json:"status,omitempty"`
+	MultiSigner *bool  `json:"dnssec_multi_signer,omitempty"`
+	Presigned   *bool  `json:"dnssec_presigned,omitempty"`
+}
+
 func resourceCloudflareZoneDNSSEC() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareZoneDNSSECSchema(),
@@ -31,7 +47,15 @@ func resourceCloudflareZoneDNSSEC() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Description: heredoc.Doc(`Provides a Cloudflare resource to create and modify zone DNSSEC settings.`),
+		Description: heredoc.Doc(`
+			Provides a Cloudflare resource to create and modify zone DNSSEC settings.
+
+			DNSSEC only becomes active once the DS record has been published
+			at the registrar. The ` + "`ds_record`" + ` and ` + "`dnskey_record`" + ` attributes
+			are formatted for registrars that take the record data directly.
+			Use ` + "`cloudflare_zone_dnssec_activation`" + ` to wait for DNSSEC to
+			become active once the DS record is published.
+		`),
 	}
 }
 
@@ -42,14 +66,18 @@ func resourceCloudflareZoneDNSSECCreate(ctx context.Context, d *schema.ResourceD
 
 	tflog.Info(ctx, fmt.Sprintf("Creating Cloudflare Zone DNSSEC: name %s", zoneID))
 
-	currentDNSSEC, err := client.ZoneDNSSECSetting(ctx, zoneID)
+	currentDNSSEC, err := zoneDNSSECSetting(ctx, client, zoneID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error finding Zone DNSSEC %q: %w", zoneID, err))
 	}
+
+	options := zoneDNSSECUpdateOptionsFromResource(d, currentDNSSEC)
 	if currentDNSSEC.Status != DNSSECStatusActive && currentDNSSEC.Status != DNSSECStatusPending {
-		_, err := client.UpdateZoneDNSSEC(ctx, zoneID, cloudflare.ZoneDNSSECUpdateOptions{Status: DNSSECStatusActive})
+		options.Status = DNSSECStatusActive
+	}
 
-		if err != nil {
+	if options != (zoneDNSSECUpdateOptions{}) {
+		if _, err := updateZoneDNSSEC(ctx, client, zoneID, options); err != nil {
 			return diag.FromErr(fmt.Errorf("error creating zone DNSSEC %q: %w", zoneID, err))
 		}
 	}
@@ -70,7 +98,7 @@ func resourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceDat
 		zoneID = d.Id()
 	}
 
-	dnssec, err := client.ZoneDNSSECSetting(ctx, zoneID)
+	dnssec, err := zoneDNSSECSetting(ctx, client, zoneID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error finding Zone DNSSEC %q: %w", zoneID, err))
 	}
@@ -91,13 +119,34 @@ func resourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("key_tag", dnssec.KeyTag)
 	d.Set("public_key", dnssec.PublicKey)
 	d.Set("modified_on", dnssec.ModifiedOn.Format(time.RFC1123Z))
+	d.Set("dnssec_multi_signer", dnssec.MultiSigner)
+	d.Set("dnssec_presigned", dnssec.Presigned)
+	d.Set("ds_record", dnssecDSRecord(dnssec.ZoneDNSSEC))
+	d.Set("dnskey_record", dnssecDNSKEYRecord(dnssec.ZoneDNSSEC))
 
 	return nil
 }
 
-// Just returning remote state since changing the zone ID would force a new resource.
 func resourceCloudflareZoneDNSSECUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return resourceCloudflareZoneRead(ctx, d, meta)
+	client := meta.(*cloudflare.API)
+
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	if d.HasChanges("dnssec_multi_signer", "dnssec_presigned") {
+		currentDNSSEC, err := zoneDNSSECSetting(ctx, client, zoneID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error finding Zone DNSSEC %q: %w", zoneID, err))
+		}
+
+		options := zoneDNSSECUpdateOptionsFromResource(d, currentDNSSEC)
+		if options != (zoneDNSSECUpdateOptions{}) {
+			if _, err := updateZoneDNSSEC(ctx, client, zoneID, options); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating zone DNSSEC %q: %w", zoneID, err))
+			}
+		}
+	}
+
+	return resourceCloudflareZoneDNSSECRead(ctx, d, meta)
 }
 
 func resourceCloudflareZoneDNSSECDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -120,3 +169,67 @@ func resourceCloudflareZoneDNSSECDelete(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+// zoneDNSSECUpdateOptionsFromResource returns the options needed to bring
+// the multi-signer and presigned settings in line with the configuration.
+// Settings which aren't configured are left as they are.
+func zoneDNSSECUpdateOptionsFromResource(d *schema.ResourceData, current zoneDNSSEC) zoneDNSSECUpdateOptions {
+	var options zoneDNSSECUpdateOptions
+
+	if multiSigner, exists := d.GetOkExists("dnssec_multi_signer"); exists && multiSigner.(bool) != current.MultiSigner {
+		options.MultiSigner = cloudflare.BoolPtr(multiSigner.(bool))
+	}
+
+	if presigned, exists := d.GetOkExists("dnssec_presigned"); exists && presigned.(bool) != current.Presigned {
+		options.Presigned = cloudflare.BoolPtr(presigned.(bool))
+	}
+
+	return options
+}
+
+func zoneDNSSECSetting(ctx context.Context, client *cloudflare.API, zoneID string) (zoneDNSSEC, error) {
+	var dnssec zoneDNSSEC
+
+	res, err := client.Raw(ctx, http.MethodGet, "/zones/"+zoneID+"/dnssec", nil, nil)
+	if err != nil {
+		return dnssec, err
+	}
+
+	err = json.Unmarshal(res.Result, &dnssec)
+
+	return dnssec, err
+}
+
+func updateZoneDNSSEC(ctx context.Context, client *cloudflare.API, zoneID string, options zoneDNSSECUpdateOptions) (zoneDNSSEC, error) {
+	var dnssec zoneDNSSEC
+
+	res, err := client.Raw(ctx, http.MethodPatch, "/zones/"+zoneID+"/dnssec", options, nil)
+	if err != nil {
+		return dnssec, err
+	}
+
+	err = json.Unmarshal(res.Result, &dnssec)
+
+	return dnssec, err
+}
+
+// dnssecDSRecord returns the data of the DS record to publish at the
+// registrar, e.g. "2371 13 2 1F98...".
+func dnssecDSRecord(dnssec cloudflare.ZoneDNSSEC) string {
+	if dnssec.Digest == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%d %s %s %s", dnssec.KeyTag, dnssec.Algorithm, dnssec.DigestType, dnssec.Digest)
+}
+
+// dnssecDNSKEYRecord returns the data of the DNSKEY record, for registrars
+// that compute the DS record themselves, e.g. "257 3 13 mdss...".
+func dnssecDNSKEYRecord(dnssec cloudflare.ZoneDNSSEC) string {
+	if dnssec.PublicKey == "" {
+		return ""
+	}
+
+	// The protocol field is always 3 (RFC 4034).
+	return fmt.Sprintf("%d 3 %s %s", dnssec.Flags, dnssec.Algorithm, dnssec.PublicKey)
+}
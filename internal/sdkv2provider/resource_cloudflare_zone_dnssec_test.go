@@ -6,8 +6,10 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareZoneDNSSECFull(t *testing.T) {
@@ -35,8 +37,29 @@ func TestAccCloudflareZoneDNSSECFull(t *testing.T) {
 					resource.TestCheckResourceAttrSet(name, "key_tag"),
 					resource.TestCheckResourceAttrSet(name, "public_key"),
 					resource.TestCheckResourceAttrSet(name, "modified_on"),
+					resource.TestCheckResourceAttr(name, "dnssec_multi_signer", "false"),
+					resource.TestCheckResourceAttr(name, "dnssec_presigned", "false"),
+					resource.TestMatchResourceAttr(name, "ds_record", regexp.MustCompile(`^\d+ \d+ \d+ [0-9A-F]+$`)),
+					resource.TestMatchResourceAttr(name, "dnskey_record", regexp.MustCompile(`^\d+ 3 \d+ \S+$`)),
 				),
 			},
 		},
 	})
 }
+
+func TestDNSSECRecordFormatting(t *testing.T) {
+	dnssec := cloudflare.ZoneDNSSEC{
+		Flags:      257,
+		Algorithm:  "13",
+		DigestType: "2",
+		Digest:     "48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+		KeyTag:     42,
+		PublicKey:  "oXiGYrSTO+LSCJ3mohc8EP+CzF9KxBj8/ydXJ22pKuZP3VAC3/Md/k7xZfz470CoRyZJ6gV6vml07IC3d8xqhA==",
+	}
+
+	assert.Equal(t, "42 13 2 48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45", dnssecDSRecord(dnssec))
+	assert.Equal(t, "257 3 13 oXiGYrSTO+LSCJ3mohc8EP+CzF9KxBj8/ydXJ22pKuZP3VAC3/Md/k7xZfz470CoRyZJ6gV6vml07IC3d8xqhA==", dnssecDNSKEYRecord(dnssec))
+
+	assert.Empty(t, dnssecDSRecord(cloudflare.ZoneDNSSEC{}))
+	assert.Empty(t, dnssecDNSKEYRecord(cloudflare.ZoneDNSSEC{}))
+}
@@ -11,6 +11,7 @@ import (
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -230,7 +231,7 @@ func flattenReadOnlyZoneSettings(ctx context.Context, settings []cloudflare.Zone
 func updateSingleZoneSettings(ctx context.Context, zoneSettings []cloudflare.ZoneSetting, client *cloudflare.API, zoneID string) ([]cloudflare.ZoneSetting, error) {
 	var indexesToCut []int
 	for i, setting := range zoneSettings {
-		if contains(fetchAsSingleSetting, setting.ID) {
+		if utils.Contains(fetchAsSingleSetting, setting.ID) {
 			_, err := client.UpdateZoneSetting(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.UpdateZoneSettingParams{
 				Name:  setting.ID,
 				Value: setting.Value,
@@ -338,7 +339,7 @@ func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, re
 }
 
 func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settingValue interface{}, readOnlySettings []string) (interface{}, error) {
-	if contains(readOnlySettings, k) {
+	if utils.Contains(readOnlySettings, k) {
 		return nil, fmt.Errorf("invalid zone setting %q (value: %v) found - cannot be set as it is read only", k, settingValue)
 	}
 
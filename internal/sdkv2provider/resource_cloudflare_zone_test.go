@@ -1,11 +1,14 @@
 package sdkv2provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareZone_Basic(t *testing.T) {
@@ -303,3 +306,102 @@ func testZoneConfigWithTypeSetup(resourceID, zoneName, paused, jumpStart, plan,
 					type = "%[7]s"
 				}`, resourceID, zoneName, paused, jumpStart, plan, accountID, zoneType)
 }
+
+func TestResourceCloudflareZoneCustomizeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "023e105f4ecef8ad9ca31a8372d0c353",
+		Attributes: map[string]string{
+			"id":                  "023e105f4ecef8ad9ca31a8372d0c353",
+			"account_id":          "f037e56e89293a057740de681ac9abbe",
+			"allow_account_move":  "false",
+			"zone":                "example.com",
+			"jump_start":          "false",
+			"paused":              "false",
+			"plan":                planIDFree,
+			"type":                "full",
+			"wait_for_activation": "false",
+		},
+	}
+
+	testCases := map[string]struct {
+		config        map[string]interface{}
+		expectedError string
+		expectedPlan  string
+	}{
+		"unchanged": {
+			config: map[string]interface{}{
+				"account_id": "f037e56e89293a057740de681ac9abbe",
+				"zone":       "example.com",
+			},
+		},
+		"plan upgrade is in place": {
+			config: map[string]interface{}{
+				"account_id": "f037e56e89293a057740de681ac9abbe",
+				"zone":       "example.com",
+				"plan":       planIDBusiness,
+			},
+			expectedPlan: planIDBusiness,
+		},
+		"plan change between partner and self-serve plans": {
+			config: map[string]interface{}{
+				"account_id": "f037e56e89293a057740de681ac9abbe",
+				"zone":       "example.com",
+				"plan":       planIDPartnerPro,
+			},
+			expectedError: `plan can't be changed from "free" to "partners_pro" in place`,
+		},
+		"account move without confirmation": {
+			config: map[string]interface{}{
+				"account_id": "01a7362d577a6c3019a474fd6f485823",
+				"zone":       "example.com",
+			},
+			expectedError: "Set allow_account_move = true to confirm the move",
+		},
+		"account move with confirmation is in place": {
+			config: map[string]interface{}{
+				"account_id":         "01a7362d577a6c3019a474fd6f485823",
+				"allow_account_move": true,
+				"zone":               "example.com",
+			},
+		},
+		"account move with plan change": {
+			config: map[string]interface{}{
+				"account_id":         "01a7362d577a6c3019a474fd6f485823",
+				"allow_account_move": true,
+				"zone":               "example.com",
+				"plan":               planIDPro,
+			},
+			expectedError: "apply the account move first, then the plan change",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diff, err := resourceCloudflareZone().Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			if diff == nil {
+				return
+			}
+
+			assert.False(t, diff.RequiresNew())
+			if tc.expectedPlan != "" {
+				assert.Equal(t, tc.expectedPlan, diff.Attributes["plan"].New)
+			}
+		})
+	}
+}
+
+func TestValidateZonePlanChange(t *testing.T) {
+	assert.NoError(t, validateZonePlanChange("", planIDPro))
+	assert.NoError(t, validateZonePlanChange(planIDFree, planIDEnterprise))
+	assert.NoError(t, validateZonePlanChange(planIDEnterprise, planIDFree))
+	assert.NoError(t, validateZonePlanChange(planIDPartnerFree, planIDPartnerEnterprise))
+	assert.Error(t, validateZonePlanChange(planIDPartnerBusiness, planIDBusiness))
+	assert.Error(t, validateZonePlanChange(planIDPro, planIDPartnerPro))
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -415,7 +416,7 @@ func convertCORSSchemaToStruct(d *schema.ResourceData) (*cloudflare.AccessApplic
 		// a wildcard (aka all origins) and using credentials.
 		// See https://developer.mozilla.org/en-US/docs/Web/HTTP/CORS/Errors/CORSNotSupportingCredentials
 		if CORSConfig.AllowCredentials {
-			if contains(CORSConfig.AllowedOrigins, "*") || CORSConfig.AllowAllOrigins {
+			if utils.Contains(CORSConfig.AllowedOrigins, "*") || CORSConfig.AllowAllOrigins {
 				return nil, errors.New("CORS credentials are not permitted when all origins are allowed")
 			}
 		}
@@ -66,5 +66,21 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateSchema() map[string]*s
 			ForceNew:     true,
 			Description:  fmt.Sprintf("The form of Authenticated Origin Pulls to upload the certificate to. %s", renderAvailableDocumentationValuesStringSlice([]string{"per-zone", "per-hostname"})),
 		},
+		"hostnames": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: fmt.Sprintf("Hostnames to enable Per-Hostname Authenticated Origin Pulls on using this certificate. Hostnames listed here should not also be managed with `%s`. Only available for `per-hostname` certificates.", "cloudflare_authenticated_origin_pulls"),
+		},
+		"hostname_status": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "The Per-Hostname Authenticated Origin Pulls status of each hostname in `hostnames`.",
+		},
 	}
 }
@@ -77,7 +77,18 @@ func resourceCloudflareCertificatePackSchema() map[string]*schema.Schema {
 			ForceNew:    true,
 			Optional:    true,
 			Default:     false,
-			Description: "Whether or not to wait for a certificate pack to reach status `active` during creation.",
+			Description: "Whether or not to wait for a certificate pack to reach status `active` during creation, and after its validation is restarted.",
+		},
+		"restart_validation": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Arbitrary map of values that, when changed, restarts the validation of a certificate pack which isn't active yet, such as after fixing the DNS records of a pack whose validation timed out.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Status of the certificate pack.",
 		},
 	}
 }
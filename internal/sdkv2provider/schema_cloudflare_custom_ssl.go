@@ -16,8 +16,9 @@ func resourceCloudflareCustomSslSchema() map[string]*schema.Schema {
 			Required:    true,
 		},
 		"custom_ssl_priority": {
-			Type:     schema.TypeList,
-			Optional: true,
+			Type:       schema.TypeList,
+			Optional:   true,
+			Deprecated: "custom_ssl_priority has been deprecated in favour of using `cloudflare_custom_ssl_ordering` resource instead.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"id": {
@@ -40,15 +41,17 @@ func resourceCloudflareCustomSslSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"certificate": {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Description: "Certificate certificate and the intermediate(s).",
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validateCustomSSLCertificate,
+						Description:      "Certificate certificate and the intermediate(s). The leaf certificate must come first, followed by the intermediates in order.",
 					},
 					"private_key": {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Sensitive:   true,
-						Description: "Certificate's private key.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						Sensitive:        true,
+						ValidateDiagFunc: validateCustomSSLPrivateKey,
+						Description:      "Certificate's private key.",
 					},
 					"bundle_method": {
 						Type:         schema.TypeString,
@@ -106,5 +109,11 @@ func resourceCloudflareCustomSslSchema() map[string]*schema.Schema {
 			Type:     schema.TypeInt,
 			Computed: true,
 		},
+		"min_days_for_renewal": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Number of days prior to the expiry of the certificate within which each plan warns that a renewed certificate should be supplied.",
+		},
 	}
 }
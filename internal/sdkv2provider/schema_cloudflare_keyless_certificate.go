@@ -50,6 +50,33 @@ func resourceCloudflareKeylessCertificateSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Whether the KeyLess SSL is on.",
 		},
+		"tunnel": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Routes connections to the key server through a Cloudflare Tunnel rather than over the public internet. Adding or removing this block will force creation of a new resource.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vnet_id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: fmt.Sprintf("The ID of the %s the key server is reachable in.", "`cloudflare_tunnel_virtual_network`"),
+					},
+					"private_ip": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPAddress,
+						Description:  "The private IP address of the key server within the virtual network.",
+					},
+				},
+			},
+		},
+		"wait_for_active": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether to wait for the key server to be reported as active after it is created or its host, port or tunnel change.",
+		},
 		"status": {
 			Description: "Status of the KeyLess SSL.",
 			Type:        schema.TypeString,
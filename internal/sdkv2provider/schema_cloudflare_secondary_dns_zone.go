@@ -1 +0,0 @@
-package sdkv2provider
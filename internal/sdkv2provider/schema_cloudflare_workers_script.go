@@ -160,6 +160,26 @@ var placementResource = &schema.Resource{
 	},
 }
 
+var tailConsumerResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"service": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the Worker script that consumes the events.",
+		},
+		"environment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The environment of the consuming Worker script.",
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The dispatch namespace of the consuming Worker script.",
+		},
+	},
+}
+
 func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		consts.AccountIDSchemaKey: {
@@ -207,6 +227,12 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 			Optional: true,
 			Elem:     placementResource,
 		},
+		"tail_consumers": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        tailConsumerResource,
+			Description: "Worker scripts that receive the trace events of this script. Services outside of a dispatch namespace that are not a Worker script in the same account produce a warning once the script is uploaded.",
+		},
 		"plain_text_binding": {
 			Type:     schema.TypeSet,
 			Optional: true,
@@ -15,6 +15,12 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 			Required:    true,
 			Description: "Account ID to manage the zone resource in.",
 		},
+		"allow_account_move": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether changing `account_id` moves the existing zone to the new account. The zone and its configuration are kept, but account level resources referencing it are not moved. When `false`, changing `account_id` fails at plan time.",
+		},
 		"zone": {
 			Type:             schema.TypeString,
 			Required:         true,
@@ -57,7 +63,7 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 				planIDPartnerBusiness,
 				planIDPartnerEnterprise,
 			}, false),
-			Description: fmt.Sprintf("The name of the commercial plan to apply to the zone. %s", renderAvailableDocumentationValuesStringSlice([]string{
+			Description: fmt.Sprintf("The name of the commercial plan to apply to the zone. Changing the plan updates the zone's rate plan subscription in place. %s", renderAvailableDocumentationValuesStringSlice([]string{
 				planIDFree,
 				planIDLite,
 				planIDPro,
@@ -98,6 +104,12 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 			},
 			Description: "Cloudflare-assigned name servers. This is only populated for zones that use Cloudflare DNS.",
 		},
+		"wait_for_activation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to repeatedly trigger the activation check and wait for the zone to reach status `active`. Bound by the create and update timeouts, a zone that isn't active by then produces a warning.",
+		},
 		"verification_key": {
 			Type:        schema.TypeString,
 			Computed:    true,
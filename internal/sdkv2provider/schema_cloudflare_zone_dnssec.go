@@ -69,5 +69,27 @@ func resourceCloudflareZoneDNSSECSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "Zone DNSSEC updated time.",
 		},
+		"dnssec_multi_signer": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether multi-signer DNSSEC is enabled, allowing multiple providers to serve a DNSSEC-signed zone at the same time. The DNSKEY records of the other providers must be added as `DNSKEY` records.",
+		},
+		"dnssec_presigned": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether DNSSEC records transferred from the primary of a secondary zone are served as-is instead of Cloudflare signing the zone.",
+		},
+		"ds_record": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The data of the DS record to publish at the registrar, in the form `<key tag> <algorithm> <digest type> <digest>`.",
+		},
+		"dnskey_record": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The data of the DNSKEY record, in the form `<flags> <protocol> <algorithm> <public key>`, for registrars that compute the DS record themselves.",
+		},
 	}
 }
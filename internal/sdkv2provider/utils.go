@@ -75,17 +75,6 @@ func stringListChecksum(s []string) string {
 	return stringChecksum(strings.Join(s, ""))
 }
 
-// Returns true if string value exists in string slice.
-func contains(slice []string, item string) bool {
-	set := make(map[string]struct{}, len(slice))
-	for _, s := range slice {
-		set[s] = struct{}{}
-	}
-
-	_, ok := set[item]
-	return ok
-}
-
 func sliceContainsInt(s []int, e int) bool {
 	for _, a := range s {
 		if a == e {
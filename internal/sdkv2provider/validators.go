@@ -1,36 +1,116 @@
 package sdkv2provider
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"math"
 	"net"
 	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 )
 
 var (
 	allowedHTTPMethods = []string{"GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "_ALL_"}
 	allowedSchemes     = []string{"HTTP", "HTTPS", "_ALL_"}
+
+	// proxiableRecordTypes are the DNS record types that can be proxied.
+	proxiableRecordTypes = []string{"A", "AAAA", "CNAME"}
+
+	// recordContentFormats describe the `value` of structured DNS record
+	// types for error messages.
+	recordContentFormats = map[string]string{
+		"CAA":    `<flags> <tag> "<value>"`,
+		"CERT":   "<type> <key tag> <algorithm> <certificate>",
+		"DNSKEY": "<flags> <protocol> <algorithm> <public key>",
+		"DS":     "<key tag> <algorithm> <digest type> <digest>",
+		"HTTPS":  "<priority> <target> [<params>]",
+		"LOC":    "<degrees> [<minutes> [<seconds>]] <N|S> <degrees> [<minutes> [<seconds>]] <E|W> <altitude>m [<size>m [<horizontal precision>m [<vertical precision>m]]]",
+		"NAPTR":  `<order> <preference> "<flags>" "<service>" "<regex>" <replacement>`,
+		"SMIMEA": "<usage> <selector> <matching type> <certificate>",
+		"SRV":    "[<priority>] <weight> <port> <target>",
+		"SSHFP":  "<algorithm> <type> <fingerprint>",
+		"SVCB":   "<priority> <target> [<params>]",
+		"TLSA":   "<usage> <selector> <matching type> <certificate>",
+		"URI":    `[<weight>] "<target>"`,
+	}
+
+	caaTags = []string{"issue", "issuewild", "iodef"}
+
+	// dsDigestLengths are the hexadecimal digest lengths of each DS digest
+	// type.
+	dsDigestLengths = map[int]int{1: 40, 2: 64, 3: 64, 4: 96}
+
+	// sshfpFingerprintLengths are the hexadecimal fingerprint lengths of each
+	// SSHFP fingerprint type.
+	sshfpFingerprintLengths = map[int]int{1: 40, 2: 64}
+	sshfpAlgorithms         = []int{1, 2, 3, 4, 6}
+
+	// tlsaCertificateLengths are the hexadecimal lengths of each hashed
+	// TLSA/SMIMEA matching type; full certificates (0) have no fixed length.
+	tlsaCertificateLengths = map[int]int{1: 64, 2: 128}
+
+	svcParamKeys     = []string{"mandatory", "alpn", "no-default-alpn", "port", "ipv4hint", "ech", "ipv6hint"}
+	svcParamKeyRegex = regexp.MustCompile(`^key([0-9]{1,5})$`)
+
+	naptrFlagsRegex = regexp.MustCompile(`^[a-zA-Z0-9]*$`)
 )
 
 // validateRecordType ensures that the cloudflare record type is valid.
 func validateRecordType(t string, proxied bool) error {
-	switch t {
-	case "A", "AAAA", "CNAME":
-		return nil
-	case "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB":
-		if ![]bool{proxied}[0] {
-			return nil
+	if !utils.Contains(utils.DNSRecordTypes, t) {
+		quoted := make([]string, 0, len(utils.DNSRecordTypes))
+		for _, recordType := range utils.DNSRecordTypes {
+			quoted = append(quoted, strconv.Quote(recordType))
 		}
-	default:
-		return fmt.Errorf(
-			`Invalid type %q. Valid types are "A", "AAAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB".`, t)
+
+		return fmt.Errorf(`Invalid type %q. Valid types are %s.`, t, strings.Join(quoted, ", "))
+	}
+
+	if proxied && !utils.Contains(proxiableRecordTypes, t) {
+		return fmt.Errorf("type %q cannot be proxied", t)
+	}
+
+	return nil
+}
+
+// validateRecordTTL ensures that the TTL is either automatic (1) or within
+// the range accepted by Cloudflare. Proxied records must use an automatic
+// TTL. A zero TTL is treated as unset.
+func validateRecordTTL(ttl int, proxied bool) error {
+	if ttl == 0 || ttl == 1 {
+		return nil
+	}
+
+	if proxied {
+		return fmt.Errorf("ttl must be set to 1 when `proxied` is true")
+	}
+
+	if ttl < 30 || ttl > 86400 {
+		return fmt.Errorf("ttl must be 1 (automatic) or between 30 and 86400 seconds, got: %d", ttl)
 	}
 
-	return fmt.Errorf("type %q cannot be proxied", t)
+	return nil
+}
+
+// validateRecordPriority ensures that the record priority fits in the 16 bits
+// used on the wire.
+func validateRecordPriority(priority int) error {
+	if priority < 0 || priority > math.MaxUint16 {
+		return fmt.Errorf("priority must be between 0 and %d, got: %d", math.MaxUint16, priority)
+	}
+
+	return nil
 }
 
 // validateRecordContent ensures that the record's content is valid for the
-// supplied record type. Currently only validates A and AAAA types.
+// supplied record type. Structured record types are parsed into their `data`
+// fields and validated the same way as `validateRecordData`.
 func validateRecordContent(t string, value string) error {
 	switch t {
 	case "A":
@@ -45,19 +125,661 @@ func validateRecordContent(t string, value string) error {
 		if addr == nil || !strings.Contains(value, ":") {
 			return fmt.Errorf("AAAA record must be a valid IPv6 address, got: %q", value)
 		}
-	case "TXT":
+	case "CNAME", "NS", "PTR":
+		if err := validateRecordHostname(value); err != nil {
+			return fmt.Errorf("%s record must be a valid hostname: %w", t, err)
+		}
+	case "MX":
+		// A single dot is a null MX record (RFC 7505).
+		if value == "." {
+			return nil
+		}
+		if err := validateRecordHostname(value); err != nil {
+			return fmt.Errorf("MX record must be a valid hostname: %w", err)
+		}
+	case "TXT", "SPF":
 		// Must be printable ASCII
 		for i := 0; i < len(value); i++ {
 			char := value[i]
 			if (char < 0x20) || (0x7F < char) {
-				return fmt.Errorf("TXT record must contain printable ASCII, found: %q", char)
+				return fmt.Errorf("%s record must contain printable ASCII, found: %q", t, char)
 			}
 		}
+		if len(value) > 2048 {
+			return fmt.Errorf("%s record must not be longer than 2048 characters, got: %d", t, len(value))
+		}
+	case "SRV":
+		if err := validateSRVRecordContent(value); err != nil {
+			return fmt.Errorf("SRV record %w", err)
+		}
+	case "CAA", "CERT", "DNSKEY", "DS", "HTTPS", "LOC", "NAPTR", "SMIMEA", "SSHFP", "SVCB", "TLSA", "URI":
+		data, err := recordContentToData(t, value)
+		if err != nil {
+			return fmt.Errorf("%s record content must be in the format %q: %w", t, recordContentFormats[t], err)
+		}
+		if err := recordDataValidators[t](data); err != nil {
+			return fmt.Errorf("%s record %w", t, err)
+		}
+	}
+
+	return nil
+}
+
+// validateRecordData ensures that the structured `data` fields of a record
+// are valid for the supplied record type. Fields that don't belong to the
+// type must be left at their zero value.
+func validateRecordData(t string, data map[string]interface{}) error {
+	fields, ok := utils.DNSRecordDataFields[t]
+	if !ok {
+		return fmt.Errorf("%s records do not support data, use value instead", t)
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !utils.Contains(fields, key) && !isZeroRecordDataValue(data[key]) {
+			return fmt.Errorf("%s is not a valid data field for %s records, valid fields are: %s", key, t, strings.Join(fields, ", "))
+		}
+	}
+
+	if err := recordDataValidators[t](data); err != nil {
+		return fmt.Errorf("%s record %w", t, err)
 	}
 
 	return nil
 }
 
+var recordDataValidators = map[string]func(map[string]interface{}) error{
+	"CAA":    validateCAARecordData,
+	"CERT":   validateCERTRecordData,
+	"DNSKEY": validateDNSKEYRecordData,
+	"DS":     validateDSRecordData,
+	"HTTPS":  validateSVCBRecordData,
+	"LOC":    validateLOCRecordData,
+	"NAPTR":  validateNAPTRRecordData,
+	"SMIMEA": validateTLSARecordData,
+	"SRV":    validateSRVRecordData,
+	"SSHFP":  validateSSHFPRecordData,
+	"SVCB":   validateSVCBRecordData,
+	"TLSA":   validateTLSARecordData,
+	"URI":    validateURIRecordData,
+}
+
+func validateCAARecordData(data map[string]interface{}) error {
+	if _, err := recordDataUint(data, "flags", math.MaxUint8); err != nil {
+		return err
+	}
+
+	tag := recordDataString(data, "tag")
+	if !utils.Contains(caaTags, tag) {
+		return fmt.Errorf("tag must be one of %s, got: %q", strings.Join(caaTags, ", "), tag)
+	}
+
+	value := recordDataString(data, "value")
+	if value == "" {
+		return fmt.Errorf("value must not be empty")
+	}
+
+	if tag == "iodef" {
+		u, err := url.Parse(value)
+		if err != nil || !utils.Contains([]string{"mailto", "http", "https"}, u.Scheme) {
+			return fmt.Errorf("value for iodef must be a mailto:, http:// or https:// URL, got: %q", value)
+		}
+
+		return nil
+	}
+
+	// issue and issuewild take "<domain>[; <parameters>]", where an empty
+	// domain (";") forbids issuance.
+	domain := strings.TrimSpace(strings.SplitN(value, ";", 2)[0])
+	if domain == "" {
+		return nil
+	}
+	if err := validateRecordHostname(domain); err != nil {
+		return fmt.Errorf("value for %s must be a certificate authority domain: %w", tag, err)
+	}
+
+	return nil
+}
+
+func validateCERTRecordData(data map[string]interface{}) error {
+	for _, field := range []string{"type", "key_tag"} {
+		if _, err := recordDataUint(data, field, math.MaxUint16); err != nil {
+			return err
+		}
+	}
+	if _, err := recordDataUint(data, "algorithm", math.MaxUint8); err != nil {
+		return err
+	}
+
+	return validateRecordDataBase64(data, "certificate")
+}
+
+func validateDNSKEYRecordData(data map[string]interface{}) error {
+	if _, err := recordDataUint(data, "flags", math.MaxUint16); err != nil {
+		return err
+	}
+	for _, field := range []string{"protocol", "algorithm"} {
+		if _, err := recordDataUint(data, field, math.MaxUint8); err != nil {
+			return err
+		}
+	}
+
+	return validateRecordDataBase64(data, "public_key")
+}
+
+func validateDSRecordData(data map[string]interface{}) error {
+	if _, err := recordDataUint(data, "key_tag", math.MaxUint16); err != nil {
+		return err
+	}
+	if _, err := recordDataUint(data, "algorithm", math.MaxUint8); err != nil {
+		return err
+	}
+
+	digestType, err := recordDataUint(data, "digest_type", math.MaxUint8)
+	if err != nil {
+		return err
+	}
+	length, ok := dsDigestLengths[digestType]
+	if !ok {
+		return fmt.Errorf("digest_type must be one of 1, 2, 3, 4, got: %d", digestType)
+	}
+
+	return validateRecordDataHex(data, "digest", "digest_type", digestType, length)
+}
+
+func validateSVCBRecordData(data map[string]interface{}) error {
+	priority, err := recordDataUint(data, "priority", math.MaxUint16)
+	if err != nil {
+		return err
+	}
+
+	target := recordDataString(data, "target")
+	if target != "." {
+		if err := validateRecordHostname(target); err != nil {
+			return fmt.Errorf("target must be a valid hostname or \".\": %w", err)
+		}
+	}
+
+	params, err := splitRecordContent(recordDataString(data, "value"))
+	if err != nil {
+		return err
+	}
+
+	if priority == 0 {
+		if len(params) > 0 {
+			return fmt.Errorf("value must be empty when priority is 0 (alias mode)")
+		}
+
+		return nil
+	}
+
+	seen := make(map[string]bool, len(params))
+	for _, param := range params {
+		key, value, hasValue := strings.Cut(param, "=")
+		key = strings.ToLower(key)
+		if seen[key] {
+			return fmt.Errorf("parameter %q is specified more than once", key)
+		}
+		seen[key] = true
+
+		if err := validateSvcParam(key, value, hasValue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSvcParam(key, value string, hasValue bool) error {
+	switch key {
+	case "no-default-alpn":
+		if hasValue {
+			return fmt.Errorf("parameter no-default-alpn does not take a value")
+		}
+
+		return nil
+	case "mandatory", "alpn", "port", "ipv4hint", "ipv6hint", "ech":
+		if value == "" {
+			return fmt.Errorf("parameter %s requires a value", key)
+		}
+	default:
+		matches := svcParamKeyRegex.FindStringSubmatch(key)
+		if matches == nil {
+			return fmt.Errorf("unknown parameter %q, valid parameters are %s or keyNNNNN", key, strings.Join(svcParamKeys, ", "))
+		}
+		if n, _ := strconv.Atoi(matches[1]); n > math.MaxUint16 {
+			return fmt.Errorf("parameter %q must not exceed key%d", key, math.MaxUint16)
+		}
+
+		return nil
+	}
+
+	for _, item := range strings.Split(value, ",") {
+		switch key {
+		case "mandatory":
+			if !utils.Contains(svcParamKeys, item) && !svcParamKeyRegex.MatchString(item) {
+				return fmt.Errorf("mandatory lists unknown parameter %q", item)
+			}
+		case "alpn":
+			if item == "" {
+				return fmt.Errorf("alpn must not contain empty protocol identifiers")
+			}
+		case "port":
+			if n, err := strconv.Atoi(item); err != nil || n < 0 || n > math.MaxUint16 {
+				return fmt.Errorf("port must be between 0 and %d, got: %q", math.MaxUint16, item)
+			}
+		case "ipv4hint":
+			if ip := net.ParseIP(item); ip == nil || ip.To4() == nil {
+				return fmt.Errorf("ipv4hint must only contain IPv4 addresses, got: %q", item)
+			}
+		case "ipv6hint":
+			if ip := net.ParseIP(item); ip == nil || !strings.Contains(item, ":") {
+				return fmt.Errorf("ipv6hint must only contain IPv6 addresses, got: %q", item)
+			}
+		case "ech":
+			if _, err := base64.StdEncoding.DecodeString(item); err != nil {
+				return fmt.Errorf("ech must be base64 encoded: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateLOCRecordData(data map[string]interface{}) error {
+	ranges := []struct {
+		field    string
+		min, max float64
+		integer  bool
+	}{
+		{"lat_degrees", 0, 90, true},
+		{"lat_minutes", 0, 59, true},
+		{"lat_seconds", 0, 59.999, false},
+		{"long_degrees", 0, 180, true},
+		{"long_minutes", 0, 59, true},
+		{"long_seconds", 0, 59.999, false},
+		{"altitude", -100000, 42849672.95, false},
+		{"size", 0, 90000000, false},
+		{"precision_horz", 0, 90000000, false},
+		{"precision_vert", 0, 90000000, false},
+	}
+
+	for _, r := range ranges {
+		v, err := recordDataNumber(data, r.field)
+		if err != nil {
+			return err
+		}
+		if v < r.min || v > r.max {
+			return fmt.Errorf("%s must be between %s and %s, got: %s", r.field, formatRecordNumber(r.min), formatRecordNumber(r.max), formatRecordNumber(v))
+		}
+		if r.integer && v != math.Trunc(v) {
+			return fmt.Errorf("%s must be a whole number, got: %s", r.field, formatRecordNumber(v))
+		}
+	}
+
+	if d := recordDataString(data, "lat_direction"); d != "N" && d != "S" {
+		return fmt.Errorf("lat_direction must be one of N, S, got: %q", d)
+	}
+	if d := recordDataString(data, "long_direction"); d != "E" && d != "W" {
+		return fmt.Errorf("long_direction must be one of E, W, got: %q", d)
+	}
+
+	return nil
+}
+
+func validateNAPTRRecordData(data map[string]interface{}) error {
+	for _, field := range []string{"order", "preference"} {
+		if _, err := recordDataUint(data, field, math.MaxUint16); err != nil {
+			return err
+		}
+	}
+
+	if flags := recordDataString(data, "flags"); !naptrFlagsRegex.MatchString(flags) {
+		return fmt.Errorf("flags must only contain letters and digits, got: %q", flags)
+	}
+
+	regex := recordDataString(data, "regex")
+	replacement := recordDataString(data, "replacement")
+
+	if regex != "" {
+		// The first character is the delimiter, e.g. "!^.*$!sip:info@example.com!".
+		delimiter := regex[:1]
+		parts := strings.Split(regex[1:], delimiter)
+		if len(parts) != 3 || (parts[2] != "" && parts[2] != "i") {
+			return fmt.Errorf("regex must be in the form %[1]s<pattern>%[1]s<substitution>%[1]s, got: %[2]q", delimiter, regex)
+		}
+		if _, err := regexp.Compile(parts[0]); err != nil {
+			return fmt.Errorf("regex pattern is invalid: %w", err)
+		}
+		if replacement != "" && replacement != "." {
+			return fmt.Errorf("regex and replacement are mutually exclusive, set replacement to \".\" when using regex")
+		}
+	}
+
+	if replacement != "" && replacement != "." {
+		if err := validateRecordHostname(replacement); err != nil {
+			return fmt.Errorf("replacement must be a valid hostname or \".\": %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateTLSARecordData(data map[string]interface{}) error {
+	if _, err := recordDataUint(data, "usage", 3); err != nil {
+		return err
+	}
+	if _, err := recordDataUint(data, "selector", 1); err != nil {
+		return err
+	}
+
+	matchingType, err := recordDataUint(data, "matching_type", 2)
+	if err != nil {
+		return err
+	}
+
+	return validateRecordDataHex(data, "certificate", "matching_type", matchingType, tlsaCertificateLengths[matchingType])
+}
+
+func validateSRVRecordData(data map[string]interface{}) error {
+	for _, field := range []string{"service", "proto"} {
+		if v := recordDataString(data, field); !strings.HasPrefix(v, "_") || len(v) < 2 {
+			return fmt.Errorf("%s must start with an underscore, e.g. \"_sip\" or \"_tcp\", got: %q", field, v)
+		}
+	}
+
+	if name := recordDataString(data, "name"); name != "" && name != "@" {
+		if err := validateRecordHostname(name); err != nil {
+			return fmt.Errorf("name must be a valid hostname: %w", err)
+		}
+	}
+
+	for _, field := range []string{"priority", "weight", "port"} {
+		if _, err := recordDataUint(data, field, math.MaxUint16); err != nil {
+			return err
+		}
+	}
+
+	return validateSRVTarget(recordDataString(data, "target"))
+}
+
+func validateSRVRecordContent(value string) error {
+	fields, err := splitRecordContent(value)
+	if err != nil {
+		return err
+	}
+	if len(fields) != 3 && len(fields) != 4 {
+		return fmt.Errorf("content must be in the format %q, got: %q", recordContentFormats["SRV"], value)
+	}
+
+	names := []string{"weight", "port"}
+	if len(fields) == 4 {
+		names = []string{"priority", "weight", "port"}
+	}
+
+	data := make(map[string]interface{}, len(names))
+	for i, name := range names {
+		data[name] = fields[i]
+		if _, err := recordDataUint(data, name, math.MaxUint16); err != nil {
+			return err
+		}
+	}
+
+	return validateSRVTarget(fields[len(fields)-1])
+}
+
+func validateSRVTarget(target string) error {
+	// A single dot means the service is decidedly not available.
+	if target == "." {
+		return nil
+	}
+	if err := validateRecordHostname(target); err != nil {
+		return fmt.Errorf("target must be a valid hostname or \".\": %w", err)
+	}
+
+	return nil
+}
+
+func validateSSHFPRecordData(data map[string]interface{}) error {
+	algorithm, err := recordDataUint(data, "algorithm", math.MaxUint8)
+	if err != nil {
+		return err
+	}
+	if !sliceContainsInt(sshfpAlgorithms, algorithm) {
+		return fmt.Errorf("algorithm must be one of 1 (RSA), 2 (DSA), 3 (ECDSA), 4 (Ed25519), 6 (Ed448), got: %d", algorithm)
+	}
+
+	fingerprintType, err := recordDataUint(data, "type", math.MaxUint8)
+	if err != nil {
+		return err
+	}
+	length, ok := sshfpFingerprintLengths[fingerprintType]
+	if !ok {
+		return fmt.Errorf("type must be one of 1 (SHA-1), 2 (SHA-256), got: %d", fingerprintType)
+	}
+
+	return validateRecordDataHex(data, "fingerprint", "type", fingerprintType, length)
+}
+
+func validateURIRecordData(data map[string]interface{}) error {
+	if _, err := recordDataUint(data, "weight", math.MaxUint16); err != nil {
+		return err
+	}
+
+	content := recordDataString(data, "content")
+	if u, err := url.Parse(content); err != nil || u.Scheme == "" {
+		return fmt.Errorf("content must be an absolute URI, got: %q", content)
+	}
+
+	return nil
+}
+
+// recordContentToData parses the `value` of a structured record type into
+// the equivalent `data` fields.
+func recordContentToData(t, value string) (map[string]interface{}, error) {
+	fields, err := splitRecordContent(value)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldData, err := utils.DNSRecordContentToData(t, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]interface{}, len(fieldData))
+	for k, v := range fieldData {
+		data[k] = v
+	}
+
+	return data, nil
+}
+
+// splitRecordContent splits record content on whitespace, treating double
+// quoted strings as a single field and removing the quotes.
+func splitRecordContent(value string) ([]string, error) {
+	var fields []string
+	var field strings.Builder
+	inField, inQuotes := false, false
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '\\' && inQuotes && i+1 < len(value):
+			i++
+			field.WriteByte(value[i])
+		case c == '"':
+			inQuotes = !inQuotes
+			inField = true
+		case (c == ' ' || c == '\t') && !inQuotes:
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteByte(c)
+			inField = true
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quoted string in %q", value)
+	}
+	if inField {
+		fields = append(fields, field.String())
+	}
+
+	return fields, nil
+}
+
+// validateRecordHostname ensures that a record target is a syntactically
+// valid hostname. Underscores are permitted as they're common in service
+// labels and a leading wildcard label is allowed.
+func validateRecordHostname(name string) error {
+	if name == "@" {
+		return nil
+	}
+
+	trimmed := strings.TrimSuffix(name, ".")
+	if trimmed == "" {
+		return fmt.Errorf("hostname must not be empty")
+	}
+	if len(trimmed) > 253 {
+		return fmt.Errorf("hostname must not be longer than 253 characters, got: %d", len(trimmed))
+	}
+
+	for i, label := range strings.Split(trimmed, ".") {
+		if label == "" {
+			return fmt.Errorf("hostname %q contains an empty label", name)
+		}
+		if len(label) > 63 {
+			return fmt.Errorf("hostname label %q must not be longer than 63 characters", label)
+		}
+		if label == "*" && i == 0 {
+			continue
+		}
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return fmt.Errorf("hostname label %q must not start or end with a hyphen", label)
+		}
+		for j := 0; j < len(label); j++ {
+			c := label[j]
+			switch {
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_', c >= 0x80:
+			default:
+				return fmt.Errorf("hostname %q contains invalid character %q", name, c)
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordDataString returns a `data` field as a string.
+func recordDataString(data map[string]interface{}, key string) string {
+	switch v := data[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return formatRecordNumber(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// recordDataNumber returns a numeric `data` field, which may be held as a
+// string. Unset fields are zero.
+func recordDataNumber(data map[string]interface{}, key string) (float64, error) {
+	switch v := data[key].(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case string:
+		if v == "" {
+			return 0, nil
+		}
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s must be a number, got: %q", key, v)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("%s must be a number, got: %v", key, v)
+	}
+}
+
+// recordDataUint returns an unsigned integer `data` field no larger than
+// max.
+func recordDataUint(data map[string]interface{}, key string, max int) (int, error) {
+	v, err := recordDataNumber(data, key)
+	if err != nil {
+		return 0, err
+	}
+	if v != math.Trunc(v) || v < 0 || v > float64(max) {
+		return 0, fmt.Errorf("%s must be a whole number between 0 and %d, got: %s", key, max, formatRecordNumber(v))
+	}
+
+	return int(v), nil
+}
+
+func validateRecordDataBase64(data map[string]interface{}, key string) error {
+	v := strings.Join(strings.Fields(recordDataString(data, key)), "")
+	if v == "" {
+		return fmt.Errorf("%s must not be empty", key)
+	}
+	if _, err := base64.StdEncoding.DecodeString(v); err != nil {
+		return fmt.Errorf("%s must be base64 encoded: %w", key, err)
+	}
+
+	return nil
+}
+
+// validateRecordDataHex ensures a `data` field is hexadecimal and, when
+// length is non-zero, has the length implied by the type field.
+func validateRecordDataHex(data map[string]interface{}, key, typeField string, typeValue, length int) error {
+	v := strings.Join(strings.Fields(recordDataString(data, key)), "")
+	if v == "" {
+		return fmt.Errorf("%s must not be empty", key)
+	}
+	if _, err := hex.DecodeString(v); err != nil {
+		return fmt.Errorf("%s must be an even number of hexadecimal characters, got: %q", key, v)
+	}
+	if length != 0 && len(v) != length {
+		return fmt.Errorf("%s must be %d hexadecimal characters for %s %d, got: %d", key, length, typeField, typeValue, len(v))
+	}
+
+	return nil
+}
+
+func isZeroRecordDataValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	default:
+		return false
+	}
+}
+
+func formatRecordNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
 func validateStringIP(v interface{}, k string) (warnings []string, errors []error) {
 	ip := net.ParseIP(v.(string))
 	if ip == nil {
@@ -1,9 +1,13 @@
 package sdkv2provider
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestValidateRecordType(t *testing.T) {
@@ -71,3 +75,351 @@ func TestValidateRecordName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateRecordTypeProxied(t *testing.T) {
+	t.Parallel()
+
+	for _, recordType := range utils.DNSRecordTypes {
+		err := validateRecordType(recordType, true)
+		if utils.Contains(proxiableRecordTypes, recordType) {
+			assert.NoError(t, err, recordType)
+		} else {
+			assert.EqualError(t, err, fmt.Sprintf("type %q cannot be proxied", recordType))
+		}
+
+		assert.NoError(t, validateRecordType(recordType, false), recordType)
+	}
+}
+
+func TestValidateRecordContent(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		recordType string
+		value      string
+		err        string
+	}{
+		{"A", "192.0.2.1", ""},
+		{"A", "2001:db8::1", "A record must be a valid IPv4 address"},
+		{"A", "example.com", "A record must be a valid IPv4 address"},
+		{"AAAA", "2001:db8::1", ""},
+		{"AAAA", "::ffff:192.0.2.1", ""},
+		{"AAAA", "192.0.2.1", "AAAA record must be a valid IPv6 address"},
+
+		{"CNAME", "example.com", ""},
+		{"CNAME", "example.com.", ""},
+		{"CNAME", "_acme-challenge.example.com", ""},
+		{"CNAME", "@", ""},
+		{"CNAME", "xn--bcher-kva.example", ""},
+		{"CNAME", "example..com", "contains an empty label"},
+		{"CNAME", "-example.com", "must not start or end with a hyphen"},
+		{"CNAME", "exa mple.com", "contains invalid character"},
+		{"CNAME", strings.Repeat("a", 64) + ".com", "must not be longer than 63 characters"},
+		{"CNAME", strings.Repeat("a.", 127) + "com", "must not be longer than 253 characters"},
+		{"NS", "ns1.example.com", ""},
+		{"NS", "", "hostname must not be empty"},
+		{"PTR", "host.example.com", ""},
+		{"PTR", "host!.example.com", "contains invalid character"},
+		{"MX", "mail.example.com", ""},
+		{"MX", ".", ""},
+		{"MX", "mail..example.com", "contains an empty label"},
+
+		{"TXT", "v=spf1 -all", ""},
+		{"TXT", "tab\tseparated", "TXT record must contain printable ASCII"},
+		{"TXT", strings.Repeat("a", 2049), "must not be longer than 2048 characters"},
+		{"SPF", "v=spf1 include:_spf.example.com ~all", ""},
+		{"SPF", "\n", "SPF record must contain printable ASCII"},
+
+		{"SRV", "5 5060 sip.example.com", ""},
+		{"SRV", "10 5 5060 sip.example.com", ""},
+		{"SRV", "0 0 .", ""},
+		{"SRV", "5 70000 sip.example.com", "port must be a whole number between 0 and 65535"},
+		{"SRV", "5 5060", "content must be in the format"},
+		{"SRV", "5 5060 sip..example.com", "target must be a valid hostname"},
+
+		{"CAA", `0 issue "letsencrypt.org"`, ""},
+		{"CAA", "0 issue letsencrypt.org", ""},
+		{"CAA", `0 issue ";"`, ""},
+		{"CAA", `0 issuewild "letsencrypt.org; validationmethods=dns-01"`, ""},
+		{"CAA", `128 iodef "mailto:security@example.com"`, ""},
+		{"CAA", `0 iodef "https://example.com/caa"`, ""},
+		{"CAA", `0 iodef "example.com"`, "value for iodef must be a mailto:"},
+		{"CAA", `0 issuer "letsencrypt.org"`, "tag must be one of issue, issuewild, iodef"},
+		{"CAA", `256 issue "letsencrypt.org"`, "flags must be a whole number between 0 and 255"},
+		{"CAA", `0 issue "lets encrypt.org"`, "value for issue must be a certificate authority domain"},
+		{"CAA", `0 issue "letsencrypt.org`, "unterminated quoted string"},
+		{"CAA", "0 issue", "CAA record content must be in the format"},
+
+		{"CERT", "1 12345 8 TUlJQnRqQ0NB", ""},
+		{"CERT", "1 12345 8 TUlJ QnRq Q0NB", ""},
+		{"CERT", "1 70000 8 TUlJQnRqQ0NB", "key_tag must be a whole number between 0 and 65535"},
+		{"CERT", "1 12345 8 not-base64!", "certificate must be base64 encoded"},
+
+		{"DNSKEY", "257 3 13 mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ==", ""},
+		{"DNSKEY", "257 3 256 mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ==", "algorithm must be a whole number between 0 and 255"},
+		{"DNSKEY", "257 3 13", "DNSKEY record content must be in the format"},
+
+		{"DS", "2371 13 2 1F987CC6583E92DF0890718C42EBD2E3AC6D8B6C0B1B4F87A5A1D2F0F1C5D8E3", ""},
+		{"DS", "2371 13 1 1F987CC6583E92DF0890718C42EBD2E3AC6D8B6C", ""},
+		{"DS", "2371 13 2 1F987CC6583E92DF0890718C42EBD2E3 AC6D8B6C0B1B4F87A5A1D2F0F1C5D8E3", ""},
+		{"DS", "2371 13 2 1F987CC6583E92DF0890718C42EBD2E3", "digest must be 64 hexadecimal characters for digest_type 2, got: 32"},
+		{"DS", "2371 13 5 1F987CC6583E92DF0890718C42EBD2E3", "digest_type must be one of 1, 2, 3, 4"},
+		{"DS", "2371 13 2 XYZ", "digest must be an even number of hexadecimal characters"},
+
+		{"HTTPS", `1 . alpn="h2,h3"`, ""},
+		{"HTTPS", `1 . alpn="h3,h2" ipv4hint="192.0.2.1,192.0.2.2" ipv6hint="2001:db8::1" port="8443"`, ""},
+		{"HTTPS", "0 svc.example.com", ""},
+		{"HTTPS", `1 . no-default-alpn alpn=h2 mandatory=alpn key65000=foo`, ""},
+		{"HTTPS", `0 svc.example.com alpn="h2"`, "value must be empty when priority is 0 (alias mode)"},
+		{"HTTPS", `1 . alpn=h2 alpn=h3`, `parameter "alpn" is specified more than once`},
+		{"HTTPS", `1 . foo=bar`, `unknown parameter "foo"`},
+		{"HTTPS", `1 . key70000=bar`, "must not exceed key65535"},
+		{"HTTPS", `1 . port=99999`, "port must be between 0 and 65535"},
+		{"HTTPS", `1 . ipv4hint=2001:db8::1`, "ipv4hint must only contain IPv4 addresses"},
+		{"HTTPS", `1 . ipv6hint=192.0.2.1`, "ipv6hint must only contain IPv6 addresses"},
+		{"HTTPS", `1 . no-default-alpn=h2`, "parameter no-default-alpn does not take a value"},
+		{"HTTPS", `1 . mandatory=foo`, `mandatory lists unknown parameter "foo"`},
+		{"HTTPS", `1 . alpn`, "parameter alpn requires a value"},
+		{"HTTPS", `1 . ech=not-base64!`, "ech must be base64 encoded"},
+		{"HTTPS", "70000 .", "priority must be a whole number between 0 and 65535"},
+		{"HTTPS", "1", "HTTPS record content must be in the format"},
+		{"SVCB", `2 foo. alpn="h3,h2"`, ""},
+		{"SVCB", `2 foo..bar alpn="h3,h2"`, "target must be a valid hostname"},
+
+		{"LOC", "37 46 46.000 N 122 23 35.000 W 0.00m 100.00m 0.00m 0.00m", ""},
+		{"LOC", "51 30 N 0 7 W 10m", ""},
+		{"LOC", "51 N 0 W 10", ""},
+		{"LOC", "91 0 0 N 122 23 35 W 0m", "lat_degrees must be between 0 and 90"},
+		{"LOC", "37 60 0 N 122 23 35 W 0m", "lat_minutes must be between 0 and 59"},
+		{"LOC", "37 46 46 N 181 23 35 W 0m", "long_degrees must be between 0 and 180"},
+		{"LOC", "37 46 46 N 122 23 35 W -100001m", "altitude must be between -100000 and 42849672.95"},
+		{"LOC", "37 46 46 N 122 23 35 W 0m 90000001m", "size must be between 0 and 90000000"},
+		{"LOC", "37 46 46 46 N 122 23 35 W 0m", "expected N or S after lat coordinates"},
+		{"LOC", "37 46 46 122 23 35 W 0m", "expected N or S after lat coordinates"},
+		{"LOC", "37 46 46 N 122 23 35 W", "missing altitude"},
+		{"LOC", "37 46 46 N 122 23 35", "missing long direction"},
+		{"LOC", "37 46 46 N 122 23 35 W 0m 1m 1m 1m 1m", "unexpected trailing fields"},
+		{"LOC", "37.5 46 46 N 122 23 35 W 0m", "lat_degrees must be a whole number"},
+
+		{"NAPTR", `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`, ""},
+		{"NAPTR", `100 10 "S" "SIP+D2U" "" _sip._udp.example.com.`, ""},
+		{"NAPTR", `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!i" .`, ""},
+		{"NAPTR", `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" example.com`, "regex and replacement are mutually exclusive"},
+		{"NAPTR", `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com" .`, "regex must be in the form"},
+		{"NAPTR", `100 10 "U" "E2U+sip" "!^(.*$!sip:info@example.com!" .`, "regex pattern is invalid"},
+		{"NAPTR", `100 10 "U+" "E2U+sip" "" example.com`, "flags must only contain letters and digits"},
+		{"NAPTR", `70000 10 "U" "E2U+sip" "" example.com`, "order must be a whole number between 0 and 65535"},
+		{"NAPTR", `100 10 "U" "E2U+sip" ""`, "NAPTR record content must be in the format"},
+
+		{"SMIMEA", "3 1 1 " + strings.Repeat("ab", 32), ""},
+		{"TLSA", "3 1 1 " + strings.Repeat("ab", 32), ""},
+		{"TLSA", "3 1 2 " + strings.Repeat("ab", 64), ""},
+		{"TLSA", "3 0 0 308201", ""},
+		{"TLSA", "4 1 1 " + strings.Repeat("ab", 32), "usage must be a whole number between 0 and 3"},
+		{"TLSA", "3 2 1 " + strings.Repeat("ab", 32), "selector must be a whole number between 0 and 1"},
+		{"TLSA", "3 1 3 " + strings.Repeat("ab", 32), "matching_type must be a whole number between 0 and 2"},
+		{"TLSA", "3 1 1 abcd", "certificate must be 64 hexadecimal characters for matching_type 1, got: 4"},
+		{"TLSA", "3 1 1 xyz", "certificate must be an even number of hexadecimal characters"},
+
+		{"SSHFP", "4 2 " + strings.Repeat("ab", 32), ""},
+		{"SSHFP", "1 1 " + strings.Repeat("ab", 20), ""},
+		{"SSHFP", "5 2 " + strings.Repeat("ab", 32), "algorithm must be one of 1 (RSA), 2 (DSA), 3 (ECDSA), 4 (Ed25519), 6 (Ed448)"},
+		{"SSHFP", "4 3 " + strings.Repeat("ab", 32), "type must be one of 1 (SHA-1), 2 (SHA-256)"},
+		{"SSHFP", "4 2 " + strings.Repeat("ab", 20), "fingerprint must be 64 hexadecimal characters for type 2, got: 40"},
+
+		{"URI", `10 "https://example.com/path"`, ""},
+		{"URI", `"ftp://ftp.example.com/"`, ""},
+		{"URI", `10 "example.com"`, "content must be an absolute URI"},
+		{"URI", `70000 "https://example.com"`, "weight must be a whole number between 0 and 65535"},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.recordType+"/"+c.value, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRecordContent(c.recordType, c.value)
+			if c.err == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), c.err)
+			}
+		})
+	}
+}
+
+func TestValidateRecordData(t *testing.T) {
+	t.Parallel()
+
+	// Fields not set in the configuration are present with their zero value.
+	loc := func(overrides map[string]interface{}) map[string]interface{} {
+		data := map[string]interface{}{
+			"lat_degrees":    37,
+			"lat_minutes":    46,
+			"lat_seconds":    46.0,
+			"lat_direction":  "N",
+			"long_degrees":   122,
+			"long_minutes":   23,
+			"long_seconds":   35.0,
+			"long_direction": "W",
+			"altitude":       0.0,
+			"size":           100.0,
+			"precision_horz": 0.0,
+			"precision_vert": 0.0,
+			"target":         "",
+			"priority":       0,
+		}
+		for k, v := range overrides {
+			data[k] = v
+		}
+		return data
+	}
+
+	cases := []struct {
+		name       string
+		recordType string
+		data       map[string]interface{}
+		err        string
+	}{
+		{"A", "A", map[string]interface{}{"value": "192.0.2.1"}, "A records do not support data, use value instead"},
+		{"TXT", "TXT", map[string]interface{}{}, "TXT records do not support data"},
+
+		{"CAA", "CAA", map[string]interface{}{"flags": "0", "tag": "issue", "value": "letsencrypt.org"}, ""},
+		{"CAA unset flags", "CAA", map[string]interface{}{"flags": "", "tag": "issue", "value": "letsencrypt.org"}, ""},
+		{"CAA iodef", "CAA", map[string]interface{}{"flags": "0", "tag": "iodef", "value": "mailto:security@example.com"}, ""},
+		{"CAA invalid tag", "CAA", map[string]interface{}{"flags": "0", "tag": "Issue", "value": "letsencrypt.org"}, "tag must be one of issue, issuewild, iodef"},
+		{"CAA empty value", "CAA", map[string]interface{}{"flags": "0", "tag": "issue", "value": ""}, "value must not be empty"},
+		{"CAA invalid flags", "CAA", map[string]interface{}{"flags": "critical", "tag": "issue", "value": "letsencrypt.org"}, `flags must be a number, got: "critical"`},
+		{"CAA unrelated field", "CAA", map[string]interface{}{"flags": "0", "tag": "issue", "value": "letsencrypt.org", "port": 443}, "port is not a valid data field for CAA records"},
+		{"CAA unrelated zero field", "CAA", map[string]interface{}{"flags": "0", "tag": "issue", "value": "letsencrypt.org", "port": 0, "target": ""}, ""},
+
+		{"CERT", "CERT", map[string]interface{}{"type": 1, "key_tag": 12345, "algorithm": 8, "certificate": "TUlJQnRqQ0NB"}, ""},
+		{"CERT empty certificate", "CERT", map[string]interface{}{"type": 1, "key_tag": 12345, "algorithm": 8, "certificate": ""}, "certificate must not be empty"},
+		{"CERT invalid type", "CERT", map[string]interface{}{"type": 65536, "key_tag": 12345, "algorithm": 8, "certificate": "TUlJQnRqQ0NB"}, "type must be a whole number between 0 and 65535"},
+
+		{"DNSKEY", "DNSKEY", map[string]interface{}{"flags": "257", "protocol": 3, "algorithm": 13, "public_key": "mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ=="}, ""},
+		{"DNSKEY invalid flags", "DNSKEY", map[string]interface{}{"flags": "65536", "protocol": 3, "algorithm": 13, "public_key": "mdss"}, "flags must be a whole number between 0 and 65535"},
+		{"DNSKEY invalid public key", "DNSKEY", map[string]interface{}{"flags": "257", "protocol": 3, "algorithm": 13, "public_key": "!!"}, "public_key must be base64 encoded"},
+
+		{"DS", "DS", map[string]interface{}{"key_tag": 2371, "algorithm": 13, "digest_type": 2, "digest": strings.Repeat("1F", 32)}, ""},
+		{"DS SHA-384", "DS", map[string]interface{}{"key_tag": 2371, "algorithm": 13, "digest_type": 4, "digest": strings.Repeat("1F", 48)}, ""},
+		{"DS unset digest type", "DS", map[string]interface{}{"key_tag": 2371, "algorithm": 13, "digest_type": 0, "digest": strings.Repeat("1F", 32)}, "digest_type must be one of 1, 2, 3, 4, got: 0"},
+		{"DS short digest", "DS", map[string]interface{}{"key_tag": 2371, "algorithm": 13, "digest_type": 1, "digest": strings.Repeat("1F", 32)}, "digest must be 40 hexadecimal characters for digest_type 1, got: 64"},
+
+		{"HTTPS", "HTTPS", map[string]interface{}{"priority": "1", "target": ".", "value": `alpn="h2"`}, ""},
+		{"HTTPS alias", "HTTPS", map[string]interface{}{"priority": 0, "target": "svc.example.com", "value": ""}, ""},
+		{"HTTPS empty target", "HTTPS", map[string]interface{}{"priority": 1, "target": "", "value": ""}, "target must be a valid hostname or \".\""},
+		{"SVCB", "SVCB", map[string]interface{}{"priority": "2", "target": "foo.", "value": `alpn="h3,h2"`}, ""},
+		{"SVCB invalid param", "SVCB", map[string]interface{}{"priority": "2", "target": "foo.", "value": `alpn="h3,h2" bogus=1`}, `unknown parameter "bogus"`},
+
+		{"LOC", "LOC", loc(nil), ""},
+		{"LOC missing direction", "LOC", loc(map[string]interface{}{"lat_direction": ""}), `lat_direction must be one of N, S, got: ""`},
+		{"LOC invalid long direction", "LOC", loc(map[string]interface{}{"long_direction": "N"}), `long_direction must be one of E, W, got: "N"`},
+		{"LOC invalid seconds", "LOC", loc(map[string]interface{}{"lat_seconds": 60.0}), "lat_seconds must be between 0 and 59.999, got: 60"},
+		{"LOC invalid precision", "LOC", loc(map[string]interface{}{"precision_vert": -1.0}), "precision_vert must be between 0 and 90000000"},
+
+		{"NAPTR", "NAPTR", map[string]interface{}{"order": 100, "preference": 10, "flags": "S", "service": "SIP+D2U", "regex": "", "replacement": "_sip._udp.example.com"}, ""},
+		{"NAPTR regex", "NAPTR", map[string]interface{}{"order": 100, "preference": 10, "flags": "U", "service": "E2U+sip", "regex": "!^.*$!sip:info@example.com!", "replacement": ""}, ""},
+		{"NAPTR invalid replacement", "NAPTR", map[string]interface{}{"order": 100, "preference": 10, "flags": "S", "service": "SIP+D2U", "regex": "", "replacement": "_sip..example.com"}, "replacement must be a valid hostname"},
+
+		{"SMIMEA", "SMIMEA", map[string]interface{}{"usage": 3, "selector": 1, "matching_type": 1, "certificate": strings.Repeat("ab", 32)}, ""},
+		{"TLSA", "TLSA", map[string]interface{}{"usage": 3, "selector": 1, "matching_type": 2, "certificate": strings.Repeat("ab", 64)}, ""},
+		{"TLSA invalid usage", "TLSA", map[string]interface{}{"usage": 4, "selector": 1, "matching_type": 1, "certificate": strings.Repeat("ab", 32)}, "usage must be a whole number between 0 and 3"},
+
+		{"SRV", "SRV", map[string]interface{}{"service": "_xmpp-client", "proto": "_tcp", "name": "example.com", "priority": 5, "weight": 0, "port": 5222, "target": "talk.l.google.com"}, ""},
+		{"SRV apex", "SRV", map[string]interface{}{"service": "_sip", "proto": "_udp", "name": "@", "priority": 5, "weight": 0, "port": 5060, "target": "."}, ""},
+		{"SRV invalid service", "SRV", map[string]interface{}{"service": "sip", "proto": "_tcp", "name": "example.com", "priority": 5, "weight": 0, "port": 5060, "target": "sip.example.com"}, `service must start with an underscore`},
+		{"SRV invalid proto", "SRV", map[string]interface{}{"service": "_sip", "proto": "", "name": "example.com", "priority": 5, "weight": 0, "port": 5060, "target": "sip.example.com"}, `proto must start with an underscore`},
+		{"SRV invalid port", "SRV", map[string]interface{}{"service": "_sip", "proto": "_tcp", "name": "example.com", "priority": 5, "weight": 0, "port": 65536, "target": "sip.example.com"}, "port must be a whole number between 0 and 65535"},
+		{"SRV missing target", "SRV", map[string]interface{}{"service": "_sip", "proto": "_tcp", "name": "example.com", "priority": 5, "weight": 0, "port": 5060, "target": ""}, "target must be a valid hostname"},
+
+		{"SSHFP", "SSHFP", map[string]interface{}{"algorithm": 4, "type": 2, "fingerprint": strings.Repeat("ab", 32)}, ""},
+		{"SSHFP invalid algorithm", "SSHFP", map[string]interface{}{"algorithm": 0, "type": 2, "fingerprint": strings.Repeat("ab", 32)}, "algorithm must be one of"},
+		{"SSHFP invalid fingerprint", "SSHFP", map[string]interface{}{"algorithm": 4, "type": 1, "fingerprint": "zz"}, "fingerprint must be an even number of hexadecimal characters"},
+
+		{"URI", "URI", map[string]interface{}{"weight": 10, "content": "https://example.com"}, ""},
+		{"URI relative", "URI", map[string]interface{}{"weight": 10, "content": "/path"}, "content must be an absolute URI"},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRecordData(c.recordType, c.data)
+			if c.err == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), c.err)
+			}
+		})
+	}
+}
+
+func TestValidateRecordTTL(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		ttl     int
+		proxied bool
+		err     string
+	}{
+		{0, false, ""},
+		{0, true, ""},
+		{1, false, ""},
+		{1, true, ""},
+		{30, false, ""},
+		{86400, false, ""},
+		{300, true, "ttl must be set to 1 when `proxied` is true"},
+		{29, false, "ttl must be 1 (automatic) or between 30 and 86400 seconds, got: 29"},
+		{86401, false, "ttl must be 1 (automatic) or between 30 and 86400 seconds, got: 86401"},
+	}
+
+	for _, c := range cases {
+		err := validateRecordTTL(c.ttl, c.proxied)
+		if c.err == "" {
+			assert.NoError(t, err, "ttl %d proxied %t", c.ttl, c.proxied)
+		} else {
+			assert.EqualError(t, err, c.err)
+		}
+	}
+}
+
+func TestValidateRecordPriority(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, validateRecordPriority(0))
+	assert.NoError(t, validateRecordPriority(65535))
+	assert.EqualError(t, validateRecordPriority(-1), "priority must be between 0 and 65535, got: -1")
+	assert.EqualError(t, validateRecordPriority(65536), "priority must be between 0 and 65535, got: 65536")
+}
+
+func TestSplitRecordContent(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		value    string
+		expected []string
+		err      string
+	}{
+		{"", nil, ""},
+		{"a b  c", []string{"a", "b", "c"}, ""},
+		{`0 issue "letsencrypt.org"`, []string{"0", "issue", "letsencrypt.org"}, ""},
+		{`100 10 "" "E2U+sip" "" .`, []string{"100", "10", "", "E2U+sip", "", "."}, ""},
+		{`alpn="h2,h3" port=443`, []string{"alpn=h2,h3", "port=443"}, ""},
+		{`"quoted \"value\" with spaces"`, []string{`quoted "value" with spaces`}, ""},
+		{`"unterminated`, nil, "unterminated quoted string"},
+	}
+
+	for _, c := range cases {
+		got, err := splitRecordContent(c.value)
+		if c.err != "" {
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), c.err)
+			}
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, got, c.value)
+	}
+}
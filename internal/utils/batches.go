@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"context"
+	"sync"
+)
+
+// Batches splits the items into groups of at most size entries.
+func Batches(items []string, size int) [][]string {
+	if size < 1 {
+		size = 1
+	}
+
+	var result [][]string
+	for start := 0; start < len(items); start += size {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		result = append(result, items[start:end])
+	}
+
+	return result
+}
+
+// ApplyInBatches calls fn for every item, running up to size calls
+// concurrently, and returns the errors keyed by item.
+func ApplyInBatches(ctx context.Context, items []string, size int, fn func(ctx context.Context, item string) error) map[string]error {
+	var mu sync.Mutex
+	errs := make(map[string]error)
+
+	for _, batch := range Batches(items, size) {
+		var wg sync.WaitGroup
+		for _, item := range batch {
+			wg.Add(1)
+			go func(item string) {
+				defer wg.Done()
+				if err := fn(ctx, item); err != nil {
+					mu.Lock()
+					errs[item] = err
+					mu.Unlock()
+				}
+			}(item)
+		}
+		wg.Wait()
+	}
+
+	return errs
+}
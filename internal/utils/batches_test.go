@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatches(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	assert.Equal(t, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, Batches(items, 2))
+	assert.Equal(t, [][]string{{"a", "b", "c", "d", "e"}}, Batches(items, 10))
+	assert.Len(t, Batches(items, 0), 5)
+	assert.Nil(t, Batches(nil, 10))
+}
+
+func TestApplyInBatches(t *testing.T) {
+	var running, maxRunning int32
+	errs := ApplyInBatches(context.Background(), []string{"a", "b", "c", "d", "e"}, 2, func(ctx context.Context, item string) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+
+		if item == "c" {
+			return errors.New("quota exceeded")
+		}
+		return nil
+	})
+
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs["c"], "quota exceeded")
+	assert.LessOrEqual(t, maxRunning, int32(2))
+}
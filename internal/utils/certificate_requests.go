@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	KeyAlgorithmRSA   = "RSA"
+	KeyAlgorithmECDSA = "ECDSA"
+)
+
+// KeyAlgorithms are the supported algorithms of generated private keys.
+var KeyAlgorithms = []string{KeyAlgorithmRSA, KeyAlgorithmECDSA}
+
+// RSAKeySizes are the supported sizes of generated RSA keys.
+var RSAKeySizes = []int{2048, 3072, 4096}
+
+// ECDSACurves are the supported curves of generated ECDSA keys.
+var ECDSACurves = []string{"P256", "P384"}
+
+// GeneratePrivateKey generates an RSA key of the given size or an ECDSA key
+// on the given curve.
+func GeneratePrivateKey(algorithm string, rsaBits int, ecdsaCurve string) (crypto.Signer, error) {
+	switch algorithm {
+	case KeyAlgorithmRSA:
+		return rsa.GenerateKey(rand.Reader, rsaBits)
+	case KeyAlgorithmECDSA:
+		var curve elliptic.Curve
+		switch ecdsaCurve {
+		case "P256":
+			curve = elliptic.P256()
+		case "P384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported ECDSA curve %q", ecdsaCurve)
+		}
+
+		return ecdsa.GenerateKey(curve, rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm %q", algorithm)
+	}
+}
+
+// EncodePrivateKey returns the private key as a PEM encoded PKCS #8 key.
+func EncodePrivateKey(key crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal private key: %w", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// GenerateCertificateRequest returns a PEM encoded certificate signing
+// request for the hostnames, signed by the private key.
+func GenerateCertificateRequest(key crypto.Signer, subject pkix.Name, hostnames []string) (string, error) {
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  subject,
+		DNSNames: hostnames,
+	}, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to create certificate signing request: %w", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})), nil
+}
+
+// ParseCertificateRequest parses a single PEM encoded certificate signing
+// request and checks its signature.
+func ParseCertificateRequest(data string) (*x509.CertificateRequest, error) {
+	block, rest := pem.Decode([]byte(strings.TrimSpace(data)))
+	if block == nil {
+		return nil, errors.New("failed to decode PEM data, the input must be a PEM encoded certificate signing request")
+	}
+
+	if block.Type != "CERTIFICATE REQUEST" && block.Type != "NEW CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("unexpected PEM block of type %q, the input must be a certificate signing request", block.Type)
+	}
+
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return nil, errors.New("unexpected data after the certificate signing request")
+	}
+
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate signing request: %w", err)
+	}
+
+	if err := request.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("invalid certificate signing request signature: %w", err)
+	}
+
+	return request, nil
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePrivateKey(t *testing.T) {
+	key, err := GeneratePrivateKey(KeyAlgorithmRSA, 2048, "")
+	require.NoError(t, err)
+	assert.Equal(t, 2048, key.(*rsa.PrivateKey).N.BitLen())
+
+	key, err = GeneratePrivateKey(KeyAlgorithmECDSA, 0, "P384")
+	require.NoError(t, err)
+	assert.Equal(t, "P-384", key.(*ecdsa.PrivateKey).Curve.Params().Name)
+
+	_, err = GeneratePrivateKey(KeyAlgorithmECDSA, 0, "P224")
+	assert.ErrorContains(t, err, `unsupported ECDSA curve "P224"`)
+
+	_, err = GeneratePrivateKey("DSA", 0, "")
+	assert.ErrorContains(t, err, `unsupported key algorithm "DSA"`)
+}
+
+func TestGenerateCertificateRequest(t *testing.T) {
+	key, err := GeneratePrivateKey(KeyAlgorithmECDSA, 0, "P256")
+	require.NoError(t, err)
+
+	encodedKey, err := EncodePrivateKey(key)
+	require.NoError(t, err)
+
+	parsedKey, err := ParsePrivateKey(encodedKey)
+	require.NoError(t, err)
+	assert.True(t, parsedKey.(*ecdsa.PrivateKey).Equal(key))
+
+	csr, err := GenerateCertificateRequest(key, pkix.Name{CommonName: "example.com", Organization: []string{"Example"}}, []string{"example.com", "*.example.com"})
+	require.NoError(t, err)
+
+	block, _ := pem.Decode([]byte(csr))
+	require.NotNil(t, block)
+	assert.Equal(t, "CERTIFICATE REQUEST", block.Type)
+
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+	assert.NoError(t, request.CheckSignature())
+	assert.Equal(t, "example.com", request.Subject.CommonName)
+	assert.Equal(t, []string{"Example"}, request.Subject.Organization)
+	assert.Equal(t, []string{"example.com", "*.example.com"}, request.DNSNames)
+}
+
+func TestParseCertificateRequest(t *testing.T) {
+	key, err := GeneratePrivateKey(KeyAlgorithmRSA, 2048, "")
+	require.NoError(t, err)
+
+	csr, err := GenerateCertificateRequest(key, pkix.Name{CommonName: "client"}, nil)
+	require.NoError(t, err)
+
+	request, err := ParseCertificateRequest(csr)
+	require.NoError(t, err)
+	assert.Equal(t, "client", request.Subject.CommonName)
+
+	_, err = ParseCertificateRequest("not a csr")
+	assert.ErrorContains(t, err, "failed to decode PEM data")
+
+	encodedKey, err := EncodePrivateKey(key)
+	require.NoError(t, err)
+	_, err = ParseCertificateRequest(encodedKey)
+	assert.ErrorContains(t, err, `unexpected PEM block of type "PRIVATE KEY"`)
+
+	_, err = ParseCertificateRequest(csr + encodedKey)
+	assert.ErrorContains(t, err, "unexpected data after the certificate signing request")
+}
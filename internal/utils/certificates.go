@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// MinimumRSAKeySize is the smallest RSA key size considered strong enough
+	// for a certificate.
+	MinimumRSAKeySize = 2048
+
+	// MinimumECDSAKeySize is the smallest ECDSA curve size considered strong
+	// enough for a certificate.
+	MinimumECDSAKeySize = 256
+)
+
+// ParseCertificateChain parses every PEM encoded certificate in the input,
+// leaf first. Anything other than certificates in the input is an error.
+func ParseCertificateChain(data string) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+
+	rest := []byte(strings.TrimSpace(data))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("failed to decode PEM data, the input must only contain PEM encoded certificates")
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block of type %q, the input must only contain certificates", block.Type)
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate %d: %w", len(chain)+1, err)
+		}
+
+		chain = append(chain, cert)
+		rest = []byte(strings.TrimSpace(string(rest)))
+	}
+
+	if len(chain) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+
+	return chain, nil
+}
+
+// ParsePrivateKey parses a PEM encoded PKCS #1, PKCS #8 or SEC 1 private key.
+func ParsePrivateKey(data string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(data)))
+	if block == nil {
+		return nil, errors.New("failed to decode PEM encoded private key")
+	}
+
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported private key PEM block of type %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	return signer, nil
+}
+
+// PrivateKeyMatchesCertificate returns whether the private key belongs to
+// the public key of the certificate.
+func PrivateKeyMatchesCertificate(key crypto.Signer, cert *x509.Certificate) bool {
+	public, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool })
+
+	return ok && public.Equal(cert.PublicKey)
+}
+
+// ValidateCertificateChainOrder ensures each certificate in the chain is
+// signed by the one following it.
+func ValidateCertificateChainOrder(chain []*x509.Certificate) error {
+	for i := 1; i < len(chain); i++ {
+		if err := chain[i-1].CheckSignatureFrom(chain[i]); err != nil {
+			return fmt.Errorf("certificate %d (%q) is not signed by certificate %d (%q), the chain must start with the leaf certificate followed by its intermediates in order", i, chain[i-1].Subject.String(), i+1, chain[i].Subject.String())
+		}
+	}
+
+	return nil
+}
+
+// CertificateCoversHostname returns whether the certificate is valid for the
+// hostname, including through a wildcard.
+func CertificateCoversHostname(cert *x509.Certificate, hostname string) bool {
+	return cert.VerifyHostname(strings.TrimSuffix(hostname, ".")) == nil
+}
+
+// CertificateKeyWarnings returns a warning for each certificate in the chain
+// using a weak key or signature algorithm.
+func CertificateKeyWarnings(chain []*x509.Certificate) []string {
+	var warnings []string
+
+	for i, cert := range chain {
+		name := fmt.Sprintf("certificate %d (%q)", i+1, cert.Subject.String())
+
+		switch key := cert.PublicKey.(type) {
+		case *rsa.PublicKey:
+			if size := key.N.BitLen(); size < MinimumRSAKeySize {
+				warnings = append(warnings, fmt.Sprintf("%s uses a %d bit RSA key, use at least %d bits", name, size, MinimumRSAKeySize))
+			}
+		case *ecdsa.PublicKey:
+			if size := key.Curve.Params().BitSize; size < MinimumECDSAKeySize {
+				warnings = append(warnings, fmt.Sprintf("%s uses a %d bit ECDSA key, use at least %d bits", name, size, MinimumECDSAKeySize))
+			}
+		case ed25519.PublicKey:
+		default:
+			warnings = append(warnings, fmt.Sprintf("%s uses an unsupported public key type %T", name, key))
+		}
+
+		// Self-signed roots are trusted by their presence in the trust store,
+		// so their own signature doesn't matter.
+		if isSelfSigned(cert) && i > 0 {
+			continue
+		}
+
+		switch cert.SignatureAlgorithm {
+		case x509.MD2WithRSA, x509.MD5WithRSA, x509.SHA1WithRSA, x509.DSAWithSHA1, x509.ECDSAWithSHA1:
+			warnings = append(warnings, fmt.Sprintf("%s is signed using the deprecated %s algorithm", name, cert.SignatureAlgorithm))
+		}
+	}
+
+	return warnings
+}
+
+// CertificateExpiresWithin returns whether the certificate expires within
+// the given number of days from now.
+func CertificateExpiresWithin(cert *x509.Certificate, days int) bool {
+	return time.Now().AddDate(0, 0, days).After(cert.NotAfter)
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return cert.CheckSignatureFrom(cert) == nil
+}
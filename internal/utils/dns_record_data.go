@@ -0,0 +1,240 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DNSRecordTypes are the DNS record types supported by Cloudflare.
+var DNSRecordTypes = []string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB"}
+
+// DNSRecordDataFields are the `data` fields used by each structured DNS
+// record type.
+var DNSRecordDataFields = map[string][]string{
+	"CAA":    {"flags", "tag", "value"},
+	"CERT":   {"type", "key_tag", "algorithm", "certificate"},
+	"DNSKEY": {"flags", "protocol", "algorithm", "public_key"},
+	"DS":     {"key_tag", "algorithm", "digest_type", "digest"},
+	"HTTPS":  {"priority", "target", "value"},
+	"LOC": {
+		"lat_degrees", "lat_minutes", "lat_seconds", "lat_direction",
+		"long_degrees", "long_minutes", "long_seconds", "long_direction",
+		"altitude", "size", "precision_horz", "precision_vert",
+	},
+	"NAPTR":  {"order", "preference", "flags", "service", "regex", "replacement"},
+	"SMIMEA": {"usage", "selector", "matching_type", "certificate"},
+	"SRV":    {"service", "proto", "name", "priority", "weight", "port", "target"},
+	"SSHFP":  {"algorithm", "type", "fingerprint"},
+	"SVCB":   {"priority", "target", "value"},
+	"TLSA":   {"usage", "selector", "matching_type", "certificate"},
+	"URI":    {"weight", "content"},
+}
+
+// DNSTypeIntFields are the DNS record `data` fields the API expects as
+// integers.
+var DNSTypeIntFields = []string{
+	"algorithm",
+	"key_tag",
+	"type",
+	"usage",
+	"selector",
+	"matching_type",
+	"weight",
+	"priority",
+	"port",
+	"long_degrees",
+	"lat_degrees",
+	"long_minutes",
+	"lat_minutes",
+	"protocol",
+	"digest_type",
+	"order",
+	"preference",
+}
+
+// DNSTypeFloatFields are the DNS record `data` fields the API expects as
+// floating point numbers.
+var DNSTypeFloatFields = []string{
+	"size",
+	"altitude",
+	"precision_horz",
+	"precision_vert",
+	"long_seconds",
+	"lat_seconds",
+}
+
+// TransformToCloudflareDNSData converts a single DNS record `data` field
+// between the types used by Terraform and the API.
+func TransformToCloudflareDNSData(recordType string, id string, value interface{}) (newValue interface{}, err error) {
+	switch {
+	case id == "flags":
+		switch {
+		case strings.ToUpper(recordType) == "SRV":
+			newValue, err = value.(string), nil
+		case strings.ToUpper(recordType) == "NAPTR":
+			newValue, err = value.(string), nil
+		case strings.ToUpper(recordType) == "CAA", strings.ToUpper(recordType) == "DNSKEY":
+			// this is required because "flags" is shared however, it comes from
+			// the API as a float64 but the Terraform internal type is string 😢.
+			switch value.(type) {
+			case float64:
+				newValue, err = fmt.Sprintf("%.0f", value.(float64)), nil
+			case string:
+				newValue, err = value.(string), nil
+			}
+		}
+	case Contains(DNSTypeIntFields, id):
+		newValue, err = value, nil
+	case Contains(DNSTypeFloatFields, id):
+		newValue, err = value, nil
+	default:
+		newValue, err = value.(string), nil
+	}
+
+	return
+}
+
+// ParseDNSRecordDataValue converts a DNS record `data` field held as a string,
+// such as a value from a map attribute or a zone file, into the type the API
+// expects.
+func ParseDNSRecordDataValue(recordType, id, value string) (interface{}, error) {
+	switch {
+	case id == "flags":
+		return TransformToCloudflareDNSData(recordType, id, value)
+	case Contains(DNSTypeIntFields, id):
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an integer: %w", id, err)
+		}
+		return v, nil
+	case Contains(DNSTypeFloatFields, id):
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a number: %w", id, err)
+		}
+		return v, nil
+	default:
+		return value, nil
+	}
+}
+
+// FormatDNSRecordDataValue renders a DNS record `data` field returned by the
+// API as a string.
+func FormatDNSRecordDataValue(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// DNSRecordContentToData maps the whitespace separated fields of a structured
+// record's content to its `data` fields. SRV content does not hold the
+// service, protocol and name of the record so it can't be converted.
+func DNSRecordContentToData(recordType string, fields []string) (map[string]string, error) {
+	switch recordType {
+	case "LOC":
+		return ParseDNSRecordLOC(fields)
+	case "SRV":
+		return nil, fmt.Errorf("SRV content can't be converted to data")
+	case "URI":
+		if len(fields) == 1 {
+			return map[string]string{"content": fields[0]}, nil
+		}
+	}
+
+	names, ok := DNSRecordDataFields[recordType]
+	if !ok {
+		return nil, fmt.Errorf("%s records do not support data", recordType)
+	}
+
+	minFields := len(names)
+	switch recordType {
+	case "HTTPS", "SVCB":
+		// Service parameters are optional.
+		minFields = 2
+	}
+
+	if len(fields) < minFields {
+		return nil, fmt.Errorf("expected %d fields, got %d", minFields, len(fields))
+	}
+
+	data := make(map[string]string, len(names))
+	for i, name := range names[:len(names)-1] {
+		if i < len(fields) {
+			data[name] = fields[i]
+		}
+	}
+
+	last := names[len(names)-1]
+	rest := []string{}
+	if len(fields) >= len(names) {
+		rest = fields[len(names)-1:]
+	}
+
+	switch recordType {
+	case "CAA", "HTTPS", "SVCB":
+		data[last] = strings.Join(rest, " ")
+	case "CERT", "DNSKEY", "DS", "SMIMEA", "SSHFP", "TLSA":
+		// Base64 and hexadecimal data may be split by whitespace.
+		data[last] = strings.Join(rest, "")
+	default:
+		if len(rest) != 1 {
+			return nil, fmt.Errorf("expected %d fields, got %d", len(names), len(fields))
+		}
+		data[last] = rest[0]
+	}
+
+	return data, nil
+}
+
+// ParseDNSRecordLOC parses the fields of LOC record content as described in
+// RFC 1876. Omitted minutes and seconds default to 0.
+func ParseDNSRecordLOC(fields []string) (map[string]string, error) {
+	data := make(map[string]string)
+
+	i := 0
+	for _, axis := range []struct {
+		prefix     string
+		directions []string
+	}{
+		{"lat", []string{"N", "S"}},
+		{"long", []string{"E", "W"}},
+	} {
+		data[axis.prefix+"_minutes"] = "0"
+		data[axis.prefix+"_seconds"] = "0"
+
+		parts := []string{"degrees", "minutes", "seconds"}
+		for j := 0; i < len(fields) && !Contains(axis.directions, strings.ToUpper(fields[i])); i, j = i+1, j+1 {
+			if j == len(parts) {
+				return nil, fmt.Errorf("expected %s after %s coordinates, got: %q", strings.Join(axis.directions, " or "), axis.prefix, fields[i])
+			}
+			data[axis.prefix+"_"+parts[j]] = fields[i]
+		}
+		if i == len(fields) {
+			return nil, fmt.Errorf("missing %s direction", axis.prefix)
+		}
+		if _, ok := data[axis.prefix+"_degrees"]; !ok {
+			return nil, fmt.Errorf("missing %s degrees", axis.prefix)
+		}
+		data[axis.prefix+"_direction"] = strings.ToUpper(fields[i])
+		i++
+	}
+
+	meters := []string{"altitude", "size", "precision_horz", "precision_vert"}
+	if i == len(fields) {
+		return nil, fmt.Errorf("missing altitude")
+	}
+	if len(fields)-i > len(meters) {
+		return nil, fmt.Errorf("unexpected trailing fields %q", strings.Join(fields[i+len(meters):], " "))
+	}
+	for j := 0; i < len(fields); i, j = i+1, j+1 {
+		data[meters[j]] = strings.TrimSuffix(strings.ToLower(fields[i]), "m")
+	}
+
+	return data, nil
+}
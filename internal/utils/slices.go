@@ -0,0 +1,12 @@
+package utils
+
+// Contains reports whether item is in slice.
+func Contains(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+
+	return false
+}
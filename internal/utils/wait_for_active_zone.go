@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// zoneActivationCheckInterval is how often the activation check is
+	// triggered while waiting. The API only accepts a check every five
+	// minutes (every hour for free zones); extra checks are rejected.
+	zoneActivationCheckInterval = 5 * time.Minute
+
+	// zoneActivationPollInterval is how often the zone status is polled
+	// between activation checks.
+	zoneActivationPollInterval = 30 * time.Second
+)
+
+// WaitForActiveZone triggers the zone activation check and polls the zone
+// until it becomes active, the zone reaches a status it can't be activated
+// from, or the timeout expires. It backs both `wait_for_activation` on
+// `cloudflare_zone` and the `cloudflare_zone_activation` resource.
+func WaitForActiveZone(ctx context.Context, client *cloudflare.API, zoneID string, timeout time.Duration) (cloudflare.Zone, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var lastCheck time.Time
+	for {
+		zone, err := client.ZoneDetails(ctx, zoneID)
+		if err != nil {
+			return zone, fmt.Errorf("error finding zone %q: %w", zoneID, err)
+		}
+
+		switch zone.Status {
+		case "active":
+			return zone, nil
+		case "pending", "initializing":
+		default:
+			return zone, fmt.Errorf("zone %q has status %q and can't be activated", zoneID, zone.Status)
+		}
+
+		if time.Since(lastCheck) >= zoneActivationCheckInterval {
+			lastCheck = time.Now()
+			tflog.Debug(ctx, fmt.Sprintf("triggering activation check for zone %s", zoneID))
+
+			if _, err := client.ZoneActivationCheck(ctx, zoneID); err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("failed to trigger activation check for zone %s: %s", zoneID, err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return zone, fmt.Errorf("timed out waiting for zone %q to become active (status %q), check the name servers at the registrar are set to %s", zoneID, zone.Status, strings.Join(zone.NameServers, ", "))
+		case <-time.After(zoneActivationPollInterval):
+		}
+	}
+}
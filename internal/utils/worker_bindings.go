@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// ParseWorkerBindings converts the Worker binding blocks shared by
+// `cloudflare_worker_script` and `cloudflare_worker_version` into the
+// `cloudflare-go` binding types. `blocks` returns the blocks of the given
+// type with their attributes as strings.
+func ParseWorkerBindings(blocks func(name string) []interface{}, bindings map[string]cloudflare.WorkerBinding) {
+	for _, rawData := range blocks("kv_namespace_binding") {
+		data := rawData.(map[string]interface{})
+		bindings[data["name"].(string)] = cloudflare.WorkerKvNamespaceBinding{
+			NamespaceID: data["namespace_id"].(string),
+		}
+	}
+
+	for _, rawData := range blocks("plain_text_binding") {
+		data := rawData.(map[string]interface{})
+		bindings[data["name"].(string)] = cloudflare.WorkerPlainTextBinding{
+			Text: data["text"].(string),
+		}
+	}
+
+	for _, rawData := range blocks("secret_text_binding") {
+		data := rawData.(map[string]interface{})
+		bindings[data["name"].(string)] = cloudflare.WorkerSecretTextBinding{
+			Text: data["text"].(string),
+		}
+	}
+
+	for _, rawData := range blocks("webassembly_binding") {
+		data := rawData.(map[string]interface{})
+		module := base64.NewDecoder(base64.StdEncoding, strings.NewReader(data["module"].(string)))
+		bindings[data["name"].(string)] = cloudflare.WorkerWebAssemblyBinding{
+			Module: module,
+		}
+	}
+
+	for _, rawData := range blocks("service_binding") {
+		data := rawData.(map[string]interface{})
+		bindings[data["name"].(string)] = cloudflare.WorkerServiceBinding{
+			Service:     data["service"].(string),
+			Environment: cloudflare.StringPtr(data["environment"].(string)),
+		}
+	}
+
+	for _, rawData := range blocks("r2_bucket_binding") {
+		data := rawData.(map[string]interface{})
+		bindings[data["name"].(string)] = cloudflare.WorkerR2BucketBinding{
+			BucketName: data["bucket_name"].(string),
+		}
+	}
+
+	for _, rawData := range blocks("analytics_engine_binding") {
+		data := rawData.(map[string]interface{})
+		bindings[data["name"].(string)] = cloudflare.WorkerAnalyticsEngineBinding{
+			Dataset: data["dataset"].(string),
+		}
+	}
+
+	for _, rawData := range blocks("queue_binding") {
+		data := rawData.(map[string]interface{})
+
+		bindings[data["binding"].(string)] = cloudflare.WorkerQueueBinding{
+			Binding: data["binding"].(string),
+			Queue:   data["queue"].(string),
+		}
+	}
+
+	for _, rawData := range blocks("d1_database_binding") {
+		data := rawData.(map[string]interface{})
+
+		bindings[data["name"].(string)] = cloudflare.WorkerD1DatabaseBinding{
+			DatabaseID: data["database_id"].(string),
+		}
+	}
+}